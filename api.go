@@ -0,0 +1,97 @@
+package printix
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// API lists the methods of Client. Depend on it instead of *Client to
+// substitute a fake in tests.
+type API interface {
+	// Client
+	Do(ctx context.Context, method, endpoint string, body any, headers map[string]string) (*http.Response, error)
+	Ping(ctx context.Context) error
+	GetRateLimitInfo() (remaining int, reset time.Time)
+	GetTenantID() string
+	TokenExpiry() time.Time
+	TokenValid() bool
+	Close()
+
+	// Tenants
+	GetTenants(ctx context.Context) (*TenantsResponse, error)
+	GetTenant(ctx context.Context, tenantID string) (*Tenant, error)
+	FindTenantByName(ctx context.Context, name string) (*Tenant, error)
+	SetTenant(tenantID string)
+	AutoSelectTenant(ctx context.Context) (string, error)
+	InvalidateTenantCache()
+
+	// Printers
+	GetPrinters(ctx context.Context, opts *GetPrintersOptions) (*PrintersResponse, error)
+	GetAllPrinters(ctx context.Context, query string) ([]Printer, error)
+	GetAllPrintersConcurrent(ctx context.Context, query string, workers int) ([]Printer, error)
+	GetPrinter(ctx context.Context, printerID string) (*Printer, error)
+	GetPrinterCapabilities(ctx context.Context, printerID string) (*PrinterCapabilities, error)
+	CapabilityReport(ctx context.Context) ([]PrinterCapabilitySummary, error)
+	FindPrinters(ctx context.Context, pred PrinterPredicate) ([]Printer, error)
+	FindPrinterByName(ctx context.Context, name string) (*Printer, error)
+	FindPrintersByLocation(ctx context.Context, location string) ([]Printer, error)
+	InvalidatePrinterCache()
+
+	// Printing
+	Submit(ctx context.Context, job *PrintJob) (*SubmitResponse, error)
+	UploadDocument(ctx context.Context, uploadLink string, headers map[string]string, data []byte) error
+	UploadDocumentWithProgress(ctx context.Context, uploadLink string, headers map[string]string, data []byte, progress func(bytesSent, total int64)) error
+	UploadDocumentChunked(ctx context.Context, uploadLink string, headers map[string]string, r io.Reader, size, chunkSize int64) error
+	CompleteUpload(ctx context.Context, completeURL string) error
+	PrintFile(ctx context.Context, printerID, title, filePath string, options *PrintOptions) error
+	PrintFileJob(ctx context.Context, printerID, title, filePath string, options *PrintOptions) (*Job, error)
+	PrintFileByName(ctx context.Context, printerName, title, filePath string, options *PrintOptions) error
+	PrintData(ctx context.Context, printerID, title string, data []byte, pdl string, options *PrintOptions) error
+	PrintDataJob(ctx context.Context, printerID, title string, data []byte, pdl string, options *PrintOptions) (*SubmitResponse, error)
+	PrintZPL(ctx context.Context, printerID, title string, zpl []byte, options *PrintOptions) error
+	PrintCanary(ctx context.Context, printerID string) (*Job, error)
+	Broadcast(ctx context.Context, printerIDs []string, title string, data []byte, pdl string, options *PrintOptions) ([]BroadcastResult, error)
+	PrintMultiple(ctx context.Context, targets []PrintTarget, title string, data []byte, pdl string, options *PrintOptions) []error
+
+	// Jobs
+	GetJobs(ctx context.Context, opts *GetJobsOptions) ([]Job, error)
+	GetJobsPage(ctx context.Context, opts *GetJobsOptions) (*JobsResponse, error)
+	GetJobsWithPage(ctx context.Context, opts *GetJobsOptions) ([]Job, *PageInfo, error)
+	GetAllJobs(ctx context.Context, opts *GetJobsOptions) ([]Job, error)
+	GetJobsCursor(ctx context.Context, opts *GetJobsOptions) ([]Job, string, error)
+	GetAllJobsCursor(ctx context.Context, opts *GetJobsOptions) ([]Job, error)
+	GetJobHistory(ctx context.Context, from, to time.Time) ([]Job, error)
+	GetJob(ctx context.Context, jobID string) (*Job, error)
+	WatchJob(ctx context.Context, jobID string) (<-chan Job, error)
+	CancelJob(ctx context.Context, jobID string) error
+	CancelAllJobs(ctx context.Context, printerID string) (int, error)
+	DeleteJob(ctx context.Context, jobID string) error
+
+	// Users
+	GetUsers(ctx context.Context, opts *GetUsersOptions) (*UsersResponse, error)
+	GetUser(ctx context.Context, userID string) (*User, error)
+	SearchUsers(ctx context.Context, query string) ([]User, error)
+	CreateUser(ctx context.Context, user *User) (*User, error)
+	UpdateUser(ctx context.Context, userID string, user *User) (*User, error)
+	DeleteUser(ctx context.Context, userID string) error
+
+	// Groups
+	GetGroups(ctx context.Context, opts *GetGroupsOptions) (*GroupsResponse, error)
+	GetGroup(ctx context.Context, groupID string) (*Group, error)
+	CreateGroup(ctx context.Context, group *Group) (*Group, error)
+	UpdateGroup(ctx context.Context, groupID string, group *Group) (*Group, error)
+	PatchGroup(ctx context.Context, groupID string, changes map[string]any) (*Group, error)
+	DeleteGroup(ctx context.Context, groupID string) error
+	AddGroupMember(ctx context.Context, groupID, userID string) error
+	AddGroupMembers(ctx context.Context, groupID string, userIDs []string) error
+	RemoveGroupMember(ctx context.Context, groupID, userID string) error
+	RemoveGroupMembers(ctx context.Context, groupID string, userIDs []string) error
+
+	// Webhooks
+	VerifyWebhookEndpoint(ctx context.Context, callbackURL, secret string) error
+	ResolveWebhookEvent(ctx context.Context, e *WebhookEvent) (any, error)
+}
+
+var _ API = (*Client)(nil)
@@ -0,0 +1,294 @@
+package printix
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores API responses for cacheable GET requests. Implementations must
+// be safe for concurrent use. A ttl of zero means the entry does not expire.
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, bool)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration)
+	Delete(ctx context.Context, key string)
+}
+
+// WithCache enables response caching for GET requests on slow-changing
+// resources: the API root, tenants and printers. Jobs and other resources are
+// never cached. Responses are served from the cache for ttl; afterwards
+// responses carrying an ETag are revalidated with If-None-Match. Mutating
+// requests invalidate the cached entries for their URL and its parent
+// resources and collections.
+func WithCache(cache Cache, ttl time.Duration) Option {
+	return func(c *Client) {
+		c.cache = cache
+		c.cacheTTL = ttl
+	}
+}
+
+// cacheEntry is a cached API response.
+type cacheEntry struct {
+	Status  int         `json:"status"`
+	Header  http.Header `json:"header"`
+	Body    []byte      `json:"body"`
+	ETag    string      `json:"etag,omitempty"`
+	Expires time.Time   `json:"expires"`
+}
+
+// response builds an HTTP response from the cache entry.
+func (e *cacheEntry) response() *http.Response {
+	return &http.Response{
+		StatusCode:    e.Status,
+		Header:        e.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+	}
+}
+
+// cacheKey builds the cache key for a request. Responses depend on the
+// credentials and the requested language, so both are part of the key. The
+// generation of the resource is included so entries can be invalidated in
+// bulk by invalidateResources.
+func (c *Client) cacheKey(fullURL string, headers map[string]string) string {
+	language := headers["Accept-Language"]
+	if language == "" {
+		language = c.locale
+	}
+	return fmt.Sprintf("%s %s %d %s", c.clientID, language, c.cacheGeneration(cacheResource(fullURL)), fullURL)
+}
+
+// cacheGeneration returns the current generation of a resource.
+func (c *Client) cacheGeneration(resource string) uint64 {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	return c.cacheGens[resource]
+}
+
+// invalidateResources makes all cached entries of the resources unreachable.
+// The orphaned entries are left to expire or be evicted by the store.
+func (c *Client) invalidateResources(resources ...string) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	if c.cacheGens == nil {
+		c.cacheGens = make(map[string]uint64)
+	}
+	for _, resource := range resources {
+		c.cacheGens[resource]++
+	}
+}
+
+// cacheableResources are the resources whose GET responses may be cached.
+var cacheableResources = map[string]bool{
+	"cloudprint": true,
+	"tenants":    true,
+	"printers":   true,
+}
+
+// isCacheable reports whether GET responses for fullURL may be cached.
+func isCacheable(fullURL string) bool {
+	return cacheableResources[cacheResource(fullURL)]
+}
+
+// cacheResource returns the resource addressed by fullURL. Paths below
+// /cloudprint alternate between collection names and IDs, so the resource is
+// the last collection name in the path.
+func cacheResource(fullURL string) string {
+	u, err := url.Parse(fullURL)
+	if err != nil {
+		return ""
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	root := slices.Index(segments, "cloudprint")
+	if root < 0 {
+		return ""
+	}
+	segments = segments[root:]
+
+	name := len(segments) - 1
+	if name > 0 && name%2 == 0 {
+		name--
+	}
+	return segments[name]
+}
+
+// invalidateCache removes the cached entries for fullURL and for every parent
+// resource and collection up to /cloudprint.
+func (c *Client) invalidateCache(ctx context.Context, fullURL string, headers map[string]string) {
+	c.cache.Delete(ctx, c.cacheKey(fullURL, headers))
+
+	u, err := url.Parse(fullURL)
+	if err != nil {
+		return
+	}
+	u.RawQuery = ""
+
+	for {
+		c.cache.Delete(ctx, c.cacheKey(u.String(), headers))
+
+		i := strings.LastIndex(u.Path, "/")
+		if i <= 0 || path.Base(u.Path) == "cloudprint" {
+			return
+		}
+		u.Path = u.Path[:i]
+	}
+}
+
+// loadCacheEntry returns the cached entry for a key.
+func (c *Client) loadCacheEntry(ctx context.Context, key string) (*cacheEntry, bool) {
+	data, ok := c.cache.Get(ctx, key)
+	if !ok {
+		return nil, false
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		c.cache.Delete(ctx, key)
+		return nil, false
+	}
+
+	return &entry, true
+}
+
+// storeCacheEntry saves an entry in the cache. Entries with an ETag are kept
+// beyond their freshness so they can be revalidated.
+func (c *Client) storeCacheEntry(ctx context.Context, key string, entry *cacheEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	ttl := c.cacheTTL
+	if entry.ETag != "" {
+		ttl = 0
+	}
+	c.cache.Set(ctx, key, data, ttl)
+}
+
+// cacheResponse stores a successful response, or serves the cached entry if
+// the server reports it as not modified.
+func (c *Client) cacheResponse(ctx context.Context, key string, cached *cacheEntry, resp *http.Response) (*http.Response, error) {
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		_ = resp.Body.Close()
+		cached.Expires = time.Now().Add(c.cacheTTL)
+		c.storeCacheEntry(ctx, key, cached)
+		return cached.response(), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+
+	c.storeCacheEntry(ctx, key, &cacheEntry{
+		Status:  resp.StatusCode,
+		Header:  resp.Header.Clone(),
+		Body:    body,
+		ETag:    resp.Header.Get("ETag"),
+		Expires: time.Now().Add(c.cacheTTL),
+	})
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// MemoryCache is an in-memory LRU Cache.
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+type memoryCacheItem struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache creates an in-memory LRU cache holding at most capacity
+// entries. A capacity of zero or less means the cache is unbounded.
+func NewMemoryCache(capacity int) *MemoryCache {
+	return &MemoryCache{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get returns the value for a key if it is present and not expired.
+func (m *MemoryCache) Get(_ context.Context, key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	item := elem.Value.(*memoryCacheItem)
+	if !item.expires.IsZero() && time.Now().After(item.expires) {
+		m.order.Remove(elem)
+		delete(m.items, key)
+		return nil, false
+	}
+
+	m.order.MoveToFront(elem)
+	return item.value, true
+}
+
+// Set stores a value, evicting the least recently used entry if the cache is full.
+func (m *MemoryCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var expires time.Time
+	if ttl > 0 {
+		expires = time.Now().Add(ttl)
+	}
+
+	if elem, ok := m.items[key]; ok {
+		item := elem.Value.(*memoryCacheItem)
+		item.value = value
+		item.expires = expires
+		m.order.MoveToFront(elem)
+		return
+	}
+
+	m.items[key] = m.order.PushFront(&memoryCacheItem{key: key, value: value, expires: expires})
+
+	if m.capacity > 0 && m.order.Len() > m.capacity {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.items, oldest.Value.(*memoryCacheItem).key)
+	}
+}
+
+// Delete removes a key from the cache.
+func (m *MemoryCache) Delete(_ context.Context, key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.items[key]; ok {
+		m.order.Remove(elem)
+		delete(m.items, key)
+	}
+}
@@ -0,0 +1,200 @@
+package printix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("hit and miss", func(t *testing.T) {
+		cache := NewMemoryCache(10)
+		_, ok := cache.Get(ctx, "a")
+		assert.False(t, ok)
+
+		cache.Set(ctx, "a", []byte("1"), 0)
+		got, ok := cache.Get(ctx, "a")
+		assert.True(t, ok)
+		assert.Equal(t, []byte("1"), got)
+
+		cache.Delete(ctx, "a")
+		_, ok = cache.Get(ctx, "a")
+		assert.False(t, ok)
+	})
+
+	t.Run("ttl expiry", func(t *testing.T) {
+		cache := NewMemoryCache(10)
+		cache.Set(ctx, "a", []byte("1"), 20*time.Millisecond)
+		_, ok := cache.Get(ctx, "a")
+		assert.True(t, ok)
+
+		time.Sleep(30 * time.Millisecond)
+		_, ok = cache.Get(ctx, "a")
+		assert.False(t, ok)
+	})
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		cache := NewMemoryCache(2)
+		cache.Set(ctx, "a", []byte("1"), 0)
+		cache.Set(ctx, "b", []byte("2"), 0)
+		_, _ = cache.Get(ctx, "a")
+		cache.Set(ctx, "c", []byte("3"), 0)
+
+		_, ok := cache.Get(ctx, "b")
+		assert.False(t, ok)
+		_, ok = cache.Get(ctx, "a")
+		assert.True(t, ok)
+		_, ok = cache.Get(ctx, "c")
+		assert.True(t, ok)
+	})
+}
+
+func TestClient_Cache(t *testing.T) {
+	var requests, revalidations int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/printers/printer-1":
+			requests++
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				revalidations++
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+				"id":      "printer-1",
+				"name":    "Office Printer",
+			})
+		}
+	}))
+	defer server.Close()
+
+	cache := NewMemoryCache(10)
+	client := New("test-id", "test-secret",
+		WithBaseURL(server.URL),
+		WithAuthURL(server.URL+"/oauth/token"),
+		WithTenantID("test-tenant"),
+		WithCache(cache, 50*time.Millisecond),
+	)
+
+	// Miss, then hit
+	for range 2 {
+		printer, err := client.GetPrinter(context.Background(), "printer-1")
+		require.NoError(t, err)
+		assert.Equal(t, "Office Printer", printer.Name)
+	}
+	assert.Equal(t, 1, requests)
+
+	// Stale entry is revalidated with the ETag
+	time.Sleep(60 * time.Millisecond)
+	printer, err := client.GetPrinter(context.Background(), "printer-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Office Printer", printer.Name)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, revalidations)
+
+	// Revalidated entry is fresh again
+	_, err = client.GetPrinter(context.Background(), "printer-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+func TestClient_CacheSkipsJobs(t *testing.T) {
+	status := "printing"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/jobs/job-1":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+				"job":     map[string]interface{}{"id": "job-1", "status": status},
+			})
+		case "/cloudprint/tenants/test-tenant/jobs/job-1/cancel":
+			status = "cancelled"
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret",
+		WithBaseURL(server.URL),
+		WithAuthURL(server.URL+"/oauth/token"),
+		WithTenantID("test-tenant"),
+		WithCache(NewMemoryCache(10), time.Minute),
+	)
+
+	job, err := client.GetJob(context.Background(), "job-1")
+	require.NoError(t, err)
+	assert.Equal(t, "printing", job.Status)
+
+	require.NoError(t, client.CancelJob(context.Background(), "job-1"))
+
+	job, err = client.GetJob(context.Background(), "job-1")
+	require.NoError(t, err)
+	assert.Equal(t, "cancelled", job.Status)
+}
+
+func TestClient_CacheInvalidatesParents(t *testing.T) {
+	requests := make(map[string]int)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+		requests[r.Method+" "+r.URL.Path]++
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret",
+		WithBaseURL(server.URL),
+		WithAuthURL(server.URL+"/oauth/token"),
+		WithCache(NewMemoryCache(10), time.Minute),
+	)
+
+	get := func(endpoint string) {
+		resp, err := client.Do(context.Background(), http.MethodGet, endpoint, nil, nil)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+
+	get("/cloudprint/tenants/t1/printers")
+	get("/cloudprint/tenants/t1")
+	get("/cloudprint/tenants/t1/printers")
+	get("/cloudprint/tenants/t1")
+	assert.Equal(t, 1, requests["GET /cloudprint/tenants/t1/printers"])
+	assert.Equal(t, 1, requests["GET /cloudprint/tenants/t1"])
+
+	resp, err := client.Do(context.Background(), http.MethodPatch, "/cloudprint/tenants/t1/printers/p1", map[string]string{"name": "x"}, nil)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	get("/cloudprint/tenants/t1/printers")
+	get("/cloudprint/tenants/t1")
+	assert.Equal(t, 2, requests["GET /cloudprint/tenants/t1/printers"])
+	assert.Equal(t, 2, requests["GET /cloudprint/tenants/t1"])
+}
@@ -0,0 +1,88 @@
+package printix
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// canaryTitle is the title used for canary print jobs.
+const canaryTitle = "Printix canary"
+
+// canaryCleanupTimeout bounds how long a canary job is polled before its
+// cleanup is given up.
+const canaryCleanupTimeout = 10 * time.Minute
+
+// canaryPDF is a minimal PDF document with a single blank A4 page.
+const canaryPDF = "%PDF-1.4\n" +
+	"1 0 obj\n<< /Type /Catalog /Pages 2 0 R >>\nendobj\n" +
+	"2 0 obj\n<< /Type /Pages /Kids [3 0 R] /Count 1 >>\nendobj\n" +
+	"3 0 obj\n<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] >>\nendobj\n" +
+	"xref\n0 4\n" +
+	"0000000000 65535 f \n" +
+	"0000000009 00000 n \n" +
+	"0000000058 00000 n \n" +
+	"0000000115 00000 n \n" +
+	"trailer\n<< /Size 4 /Root 1 0 R >>\nstartxref\n186\n%%EOF\n"
+
+// PrintCanary submits a blank test-mode job to the printer and deletes it once it
+// reaches a terminal status. Cleanup runs in the background, detached from ctx
+// so it outlives request-scoped contexts, and gives up after 10 minutes or if
+// the job cannot be fetched, e.g. because it was already purged.
+func (c *Client) PrintCanary(ctx context.Context, printerID string) (*Job, error) {
+	job := &PrintJob{
+		PrinterID: printerID,
+		Title:     canaryTitle,
+		User:      "MTS API",
+		TestMode:  Bool(true),
+	}
+
+	submitResp, err := c.submitJob(ctx, job, []byte(canaryPDF), "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("printing canary: %w", err)
+	}
+
+	result := submitResp.submittedJob(printerID)
+
+	if !c.dryRun {
+		cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), canaryCleanupTimeout)
+		go func() {
+			defer cancel()
+			c.cleanupCanary(cleanupCtx, result.ID)
+		}()
+	}
+
+	return result, nil
+}
+
+// cleanupCanary polls the job until it is terminal and then deletes it. It
+// stops early on client errors, which will not resolve by polling.
+func (c *Client) cleanupCanary(ctx context.Context, jobID string) {
+	ticker := time.NewTicker(c.jobPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		job, err := c.GetJob(ctx, jobID)
+		if err != nil {
+			c.logDebug(ctx, "canary status check failed", "job_id", jobID, "error", err)
+			if isClientError(err) {
+				return
+			}
+			continue
+		}
+		if !IsTerminalStatus(job.NormalizedStatus) {
+			continue
+		}
+
+		if err := c.DeleteJob(ctx, jobID); err != nil {
+			c.logDebug(ctx, "canary cleanup failed", "job_id", jobID, "error", err)
+		}
+		return
+	}
+}
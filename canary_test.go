@@ -0,0 +1,125 @@
+package printix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_PrintCanary(t *testing.T) {
+	var polls atomic.Int32
+	deleted := make(chan string, 1)
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case r.URL.Path == "/cloudprint/tenants/test-tenant/printers/printer-1/jobs":
+			assert.Equal(t, "true", r.URL.Query().Get("test"))
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":     true,
+				"job":         map[string]interface{}{"id": "job-1", "title": canaryTitle, "status": "Created"},
+				"uploadLinks": []map[string]interface{}{{"url": server.URL + "/upload"}},
+				"_links": map[string]interface{}{
+					"uploadCompleted": map[string]interface{}{"href": server.URL + "/complete"},
+				},
+			})
+		case r.URL.Path == "/upload":
+			w.WriteHeader(http.StatusCreated)
+		case r.URL.Path == "/complete":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		case r.URL.Path == "/cloudprint/tenants/test-tenant/jobs/job-1" && r.Method == http.MethodGet:
+			status := "Processing"
+			if polls.Add(1) > 1 {
+				status = "Completed"
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+				"job":     map[string]interface{}{"id": "job-1", "status": status},
+			})
+		case r.URL.Path == "/cloudprint/tenants/test-tenant/jobs/job-1" && r.Method == http.MethodDelete:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+			deleted <- "job-1"
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+	client.jobPollInterval = 10 * time.Millisecond
+
+	// Cleanup must outlive a request-scoped context
+	ctx, cancel := context.WithCancel(context.Background())
+	job, err := client.PrintCanary(ctx, "printer-1")
+	cancel()
+	require.NoError(t, err)
+	assert.Equal(t, "job-1", job.ID)
+	assert.Equal(t, "printer-1", job.PrinterID)
+
+	select {
+	case id := <-deleted:
+		assert.Equal(t, "job-1", id)
+	case <-time.After(2 * time.Second):
+		t.Fatal("canary job was not deleted")
+	}
+	assert.GreaterOrEqual(t, polls.Load(), int32(2))
+}
+
+func TestClient_PrintCanary_JobGone(t *testing.T) {
+	var polls, deletes atomic.Int32
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case r.URL.Path == "/cloudprint/tenants/test-tenant/printers/printer-1/jobs":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":     true,
+				"job":         map[string]interface{}{"id": "job-1", "title": canaryTitle, "status": "Created"},
+				"uploadLinks": []map[string]interface{}{{"url": server.URL + "/upload"}},
+				"_links": map[string]interface{}{
+					"uploadCompleted": map[string]interface{}{"href": server.URL + "/complete"},
+				},
+			})
+		case r.URL.Path == "/upload":
+			w.WriteHeader(http.StatusCreated)
+		case r.URL.Path == "/complete":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		case r.URL.Path == "/cloudprint/tenants/test-tenant/jobs/job-1" && r.Method == http.MethodGet:
+			polls.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+		case r.URL.Path == "/cloudprint/tenants/test-tenant/jobs/job-1" && r.Method == http.MethodDelete:
+			deletes.Add(1)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+	client.jobPollInterval = 10 * time.Millisecond
+
+	_, err := client.PrintCanary(context.Background(), "printer-1")
+	require.NoError(t, err)
+
+	// A purged job stops the cleanup after the first poll
+	time.Sleep(150 * time.Millisecond)
+	assert.Equal(t, int32(1), polls.Load())
+	assert.Equal(t, int32(0), deletes.Load())
+}
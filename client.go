@@ -3,14 +3,19 @@ package printix
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -23,21 +28,58 @@ const (
 	jobsEndpoint           = "/cloudprint/tenants/%s/jobs"
 	tokenExpirySeconds     = 3599 // 1 hour
 	tokenRenewalBuffer     = 600  // Renew 10 minutes before expiry
+	defaultUploadTimeout   = 60 * time.Second
+	defaultJobPollInterval = 5 * time.Second
+	libraryVersion         = "1.0.0"
+	defaultUserAgent       = "printix-go/" + libraryVersion
+	correlationIDHeader    = "X-Correlation-ID"
 )
 
 // Client represents a Printix API client.
 type Client struct {
-	httpClient      *http.Client
-	baseURL         string
-	authURL         string
-	clientID        string
-	clientSecret    string
-	tenantID        string
-	accessToken     string
-	tokenExpiry     time.Time
-	testMode        bool
-	rateLimitRemain int
-	rateLimitReset  time.Time
+	httpClient       *http.Client
+	transport        http.RoundTripper
+	tlsConfig        *tls.Config
+	maxIdleConns     int
+	maxConnsPerHost  int
+	baseURL          string
+	basePath         string
+	authURL          string
+	clientID         string
+	clientSecret     string
+	scope            string
+	tenantID         string
+	tokenMu          sync.Mutex
+	accessToken      string
+	tokenExpiry      time.Time
+	testMode         bool
+	userAgent        string
+	locale           string
+	logger           *slog.Logger
+	tracer           trace.Tracer
+	metrics          MetricsObserver
+	requestTimeout   time.Duration
+	uploadTimeout    time.Duration
+	v11Fallback      bool
+	strictValidation bool
+	renewalSkew      time.Duration
+	onlineCheck      bool
+	allowAnyPDL      bool
+	dryRun           bool
+	cache            Cache
+	cacheTTL         time.Duration
+	cacheMu          sync.Mutex
+	cacheGens        map[string]uint64
+	maxRetries       int
+	retryPOST        bool
+	rateLimitGuard   bool
+	rateLimitMu      sync.Mutex
+	rateLimitRemain  int
+	rateLimitReset   time.Time
+	jobPollInterval  time.Duration
+	tokenStore       TokenStore
+	onTokenRefresh   func(token string, expiry time.Time)
+	correlationID    func(ctx context.Context) string
 }
 
 // Option is a function that configures the client.
@@ -57,6 +99,19 @@ func WithBaseURL(baseURL string) Option {
 	}
 }
 
+// WithBasePath sets a path prefix for API endpoints, for deployments that
+// mount the API below the root, e.g. "/printix" for
+// https://proxy.example.com/printix/cloudprint. Absolute HAL links returned by
+// the API are used as they are.
+func WithBasePath(prefix string) Option {
+	return func(c *Client) {
+		c.basePath = strings.Trim(prefix, "/")
+		if c.basePath != "" {
+			c.basePath = "/" + c.basePath
+		}
+	}
+}
+
 // WithTestMode enables test mode for the client.
 func WithTestMode() Option {
 	return func(c *Client) {
@@ -79,35 +134,205 @@ func WithAuthURL(authURL string) Option {
 	}
 }
 
+// WithScope sets the scope requested with the OAuth token.
+func WithScope(scope string) Option {
+	return func(c *Client) {
+		c.scope = scope
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithLocale sets the Accept-Language header sent with every request, e.g.
+// "de-DE", to request localized capability strings.
+func WithLocale(tag string) Option {
+	return func(c *Client) {
+		c.locale = tag
+	}
+}
+
+// WithLogger enables debug logging of API requests and token fetches.
+// Credentials and the Authorization header are never logged.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithRequestTimeout limits the duration of each API request, independently
+// of the HTTP client timeout. The deadline covers reading the response body.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.requestTimeout = timeout
+	}
+}
+
+// WithUploadTimeout sets the timeout for document uploads to cloud storage.
+// The default is 60 seconds.
+func WithUploadTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.uploadTimeout = timeout
+	}
+}
+
+// WithV11Fallback makes Submit retry a v1.1 submission once as v1.0 when the
+// server rejects the version: with 415, or with 400 naming the version or
+// content type. Other errors, such as invalid print settings, are returned
+// unchanged. The v1.1 print settings are dropped on retry and reported in
+// SubmitResponse.Warnings.
+func WithV11Fallback() Option {
+	return func(c *Client) {
+		c.v11Fallback = true
+	}
+}
+
+// WithRateLimitGuard makes requests wait for the rate limit reset once the
+// server reports no remaining requests, instead of running into HTTP 429.
+// After a 429, requests wait for X-Rate-Limit-Retry-After-Seconds.
+func WithRateLimitGuard() Option {
+	return func(c *Client) {
+		c.rateLimitGuard = true
+	}
+}
+
+// WithStrictValidation makes Submit reject print settings with unknown values
+// before sending the request, e.g. a media size that is not one of the
+// MediaSize constants.
+func WithStrictValidation() Option {
+	return func(c *Client) {
+		c.strictValidation = true
+	}
+}
+
+// WithCorrelationIDFunc sets a function that extracts a correlation ID from the
+// request context. Non-empty IDs are sent in the X-Correlation-ID header.
+func WithCorrelationIDFunc(fn func(ctx context.Context) string) Option {
+	return func(c *Client) {
+		c.correlationID = fn
+	}
+}
+
+// WithPreSubmitConnectivityCheck makes Submit fetch the printer first and
+// fail with ErrPrinterOffline unless it is online. This costs an extra
+// request per job unless WithCache is also used.
+func WithPreSubmitConnectivityCheck() Option {
+	return func(c *Client) {
+		c.onlineCheck = true
+	}
+}
+
+// WithoutPDLValidation lets Submit pass any PrintJob.PDL to the server, e.g.
+// a PDL added to the API after this library was released.
+func WithoutPDLValidation() Option {
+	return func(c *Client) {
+		c.allowAnyPDL = true
+	}
+}
+
+// WithTokenRenewalSkew sets how long before its expiry the access token is
+// renewed. The default is 10 minutes. Negative values and values not smaller
+// than the token lifetime of one hour are ignored.
+func WithTokenRenewalSkew(d time.Duration) Option {
+	return func(c *Client) {
+		if d < 0 || d >= tokenExpirySeconds*time.Second {
+			return
+		}
+		c.renewalSkew = d
+	}
+}
+
+// WithJobPollInterval sets how often job status is polled, e.g. by WatchJob.
+// The default is 5 seconds.
+func WithJobPollInterval(interval time.Duration) Option {
+	return func(c *Client) {
+		if interval > 0 {
+			c.jobPollInterval = interval
+		}
+	}
+}
+
 // New creates a new Printix client.
 func New(clientID, clientSecret string, opts ...Option) *Client {
 	c := &Client{
-		httpClient:   &http.Client{Timeout: 30 * time.Second},
-		baseURL:      defaultBaseURL,
-		authURL:      defaultAuthURL,
-		clientID:     clientID,
-		clientSecret: clientSecret,
+		httpClient:      &http.Client{Timeout: 30 * time.Second},
+		baseURL:         defaultBaseURL,
+		authURL:         defaultAuthURL,
+		clientID:        clientID,
+		clientSecret:    clientSecret,
+		userAgent:       defaultUserAgent,
+		uploadTimeout:   defaultUploadTimeout,
+		maxRetries:      defaultMaxRetries,
+		jobPollInterval: defaultJobPollInterval,
+		renewalSkew:     tokenRenewalBuffer * time.Second,
 	}
 
 	for _, opt := range opts {
 		opt(c)
 	}
 
+	c.configureTransport()
+
 	return c
 }
 
 // authenticate gets or refreshes the OAuth access token.
 func (c *Client) authenticate(ctx context.Context) error {
+	// Hold the lock for the whole exchange so concurrent requests share one refresh
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
 	// Check if token is still valid with renewal buffer
-	if c.accessToken != "" && time.Now().Before(c.tokenExpiry.Add(-tokenRenewalBuffer*time.Second)) {
+	if c.tokenValid(c.accessToken, c.tokenExpiry) {
 		return nil
 	}
 
+	if c.loadStoredToken(ctx) {
+		return nil
+	}
+
+	return c.fetchToken(ctx)
+}
+
+// refreshToken replaces a token the server rejected. The token store is
+// skipped since it may hold the rejected token. Nothing is fetched if another
+// request already replaced the token.
+func (c *Client) refreshToken(ctx context.Context, rejected string) error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.accessToken != rejected {
+		return nil
+	}
+	c.accessToken = ""
+
+	return c.fetchToken(ctx)
+}
+
+// fetchToken requests a new access token. The caller must hold tokenMu.
+func (c *Client) fetchToken(ctx context.Context) (err error) {
+	if c.clientID == "" || c.clientSecret == "" {
+		return fmt.Errorf("access token expired and no client credentials configured")
+	}
+
+	if c.metrics != nil {
+		defer func() {
+			c.metrics.ObserveTokenRefresh(err == nil)
+		}()
+	}
+
 	data := url.Values{
 		"grant_type":    {"client_credentials"},
 		"client_id":     {c.clientID},
 		"client_secret": {c.clientSecret},
 	}
+	if c.scope != "" {
+		data.Set("scope", c.scope)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.authURL, bytes.NewBufferString(data.Encode()))
 	if err != nil {
@@ -115,11 +340,15 @@ func (c *Client) authenticate(ctx context.Context) error {
 	}
 
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", c.userAgent)
 
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		c.logDebug(ctx, "printix token request failed", "url", c.authURL, "client_id", c.clientID, "duration", time.Since(start), "error", err)
 		return fmt.Errorf("executing auth request: %w", err)
 	}
+	c.logDebug(ctx, "printix token request", "url", c.authURL, "client_id", c.clientID, "status", resp.StatusCode, "duration", time.Since(start))
 	defer func() {
 		_ = resp.Body.Close()
 	}()
@@ -146,63 +375,302 @@ func (c *Client) authenticate(ctx context.Context) error {
 	// Use the exact expiry time from response
 	c.tokenExpiry = time.Now().Add(time.Duration(authResp.ExpiresIn) * time.Second)
 
+	c.saveToken(ctx)
+	if c.onTokenRefresh != nil {
+		c.onTokenRefresh(c.accessToken, c.tokenExpiry)
+	}
+
 	return nil
 }
 
+// tokenValid reports whether token can be used without renewal.
+func (c *Client) tokenValid(token string, expiry time.Time) bool {
+	return token != "" && time.Now().Before(expiry.Add(-c.renewalSkew))
+}
+
+// currentToken returns the cached access token.
+func (c *Client) currentToken() string {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	return c.accessToken
+}
+
+// Close releases idle keep-alive connections and clears the cached access
+// token. A closed client remains usable; its next request authenticates again.
+func (c *Client) Close() {
+	c.httpClient.CloseIdleConnections()
+
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	c.accessToken = ""
+	c.tokenExpiry = time.Time{}
+}
+
+// apiURL resolves an endpoint against the base URL and path. Absolute URLs,
+// like HAL links, are returned unchanged.
+func (c *Client) apiURL(endpoint string) string {
+	if strings.HasPrefix(endpoint, "http://") || strings.HasPrefix(endpoint, "https://") {
+		return endpoint
+	}
+	if !strings.HasPrefix(endpoint, "/") {
+		endpoint = "/" + endpoint
+	}
+
+	return strings.TrimSuffix(c.baseURL, "/") + c.basePath + endpoint
+}
+
 // doRequestWithHeaders performs an authenticated HTTP request with custom headers.
-func (c *Client) doRequestWithHeaders(ctx context.Context, method, endpoint string, body any, customHeaders map[string]string) (*http.Response, error) {
-	// For absolute URLs (like HAL links), use them directly
-	fullURL := endpoint
-	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
-		fullURL = c.baseURL + endpoint
+func (c *Client) doRequestWithHeaders(ctx context.Context, method, endpoint string, body any, customHeaders map[string]string) (resp *http.Response, err error) {
+	fullURL := c.apiURL(endpoint)
+
+	ctx, span := c.startSpan(ctx, method, fullURL)
+	defer func() {
+		endSpan(span, resp, err)
+	}()
+
+	if c.metrics != nil {
+		op, start := operationName(), time.Now()
+		defer func() {
+			c.observeRequest(op, resp, start)
+		}()
+	}
+
+	if c.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+		defer func() {
+			// Keep the context alive until the caller has consumed the body.
+			if err != nil {
+				cancel()
+				return
+			}
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+		}()
+	}
+
+	// Serve fresh GET responses from the cache; stale ones are revalidated below
+	var cacheKey string
+	var cached *cacheEntry
+	if c.cache != nil {
+		if method != http.MethodGet {
+			c.invalidateCache(ctx, fullURL, customHeaders)
+		} else if isCacheable(fullURL) {
+			cacheKey = c.cacheKey(fullURL, customHeaders)
+		}
+	}
+	if cacheKey != "" {
+		if entry, ok := c.loadCacheEntry(ctx, cacheKey); ok {
+			if time.Now().Before(entry.Expires) {
+				return entry.response(), nil
+			}
+			cached = entry
+		}
+	}
+
+	if c.dryRun && isMutating(method) {
+		return c.dryRunResponse(ctx, method, fullURL), nil
+	}
+
+	if c.rateLimitGuard {
+		if err := c.waitForRateLimit(ctx); err != nil {
+			return nil, fmt.Errorf("waiting for rate limit reset: %w", err)
+		}
 	}
 
 	if err := c.authenticate(ctx); err != nil {
 		return nil, fmt.Errorf("authentication failed: %w", err)
 	}
 
-	var reqBody io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("marshaling request body: %w", err)
 		}
-		reqBody = bytes.NewReader(jsonBody)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, fullURL, reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
-	}
+	reauthenticated := false
+	for attempt := 0; ; attempt++ {
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(jsonBody)
+		}
 
-	req.Header.Set("Authorization", "Bearer "+c.accessToken)
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
-	}
-	
-	// Add custom headers
-	for key, value := range customHeaders {
-		req.Header.Set(key, value)
+		req, err := http.NewRequestWithContext(ctx, method, fullURL, reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("creating request: %w", err)
+		}
+
+		token := c.currentToken()
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("User-Agent", c.userAgent)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		if c.locale != "" {
+			req.Header.Set("Accept-Language", c.locale)
+		}
+		if c.correlationID != nil {
+			if id := c.correlationID(ctx); id != "" {
+				req.Header.Set(correlationIDHeader, id)
+			}
+		}
+
+		// Add custom headers
+		for key, value := range customHeaders {
+			req.Header.Set(key, value)
+		}
+		if cached != nil && cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+
+		start := time.Now()
+		resp, err = c.httpClient.Do(req)
+		if err == nil {
+			c.logDebug(ctx, "printix request", "method", method, "url", fullURL, "headers", redactHeaders(req.Header), "status", resp.StatusCode, "duration", time.Since(start))
+
+			// The token may have expired in flight; refresh it and retry once
+			if resp.StatusCode == http.StatusUnauthorized && !reauthenticated {
+				reauthenticated = true
+				_, _ = io.Copy(io.Discard, resp.Body)
+				_ = resp.Body.Close()
+				if err := c.refreshToken(ctx, token); err != nil {
+					return nil, fmt.Errorf("authentication failed: %w", err)
+				}
+				continue
+			}
+			break
+		}
+		c.logDebug(ctx, "printix request failed", "method", method, "url", fullURL, "headers", redactHeaders(req.Header), "duration", time.Since(start), "attempt", attempt+1, "error", err)
+
+		if attempt >= c.maxRetries || !c.canRetry(ctx, method, err) {
+			return nil, fmt.Errorf("executing request: %w", err)
+		}
+		if err := sleepBackoff(ctx, attempt); err != nil {
+			return nil, fmt.Errorf("executing request: %w", err)
+		}
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("executing request: %w", err)
+	c.updateRateLimit(resp.Header)
+
+	if cacheKey != "" {
+		return c.cacheResponse(ctx, cacheKey, cached, resp)
 	}
 
-	// Extract rate limit headers
-	if remaining := resp.Header.Get("X-Rate-Limit-Remaining"); remaining != "" {
+	return resp, nil
+}
+
+// Rate limit header spellings, in order of preference.
+var (
+	rateLimitRemainingHeaders = []string{"X-Rate-Limit-Remaining", "X-RateLimit-Remaining", "RateLimit-Remaining"}
+	rateLimitResetHeaders     = []string{"X-Rate-Limit-Reset", "X-RateLimit-Reset", "RateLimit-Reset"}
+)
+
+// rateLimitRetryAfterHeader is sent by the API with HTTP 429 and holds the
+// number of seconds to wait before the next request.
+const rateLimitRetryAfterHeader = "X-Rate-Limit-Retry-After-Seconds"
+
+// maxResetDelta separates reset values given as delta seconds from Unix
+// timestamps. No gateway asks for a wait of more than a year.
+const maxResetDelta = 365 * 24 * 60 * 60
+
+// updateRateLimit extracts the rate limit information from response headers.
+// The reset may be a Unix timestamp or, as in the RateLimit-Reset header, the
+// number of seconds until the reset. A throttled response's retry-after
+// header exhausts the remaining requests until it has passed.
+func (c *Client) updateRateLimit(h http.Header) {
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+
+	if remaining := firstHeader(h, rateLimitRemainingHeaders); remaining != "" {
 		if val, err := strconv.Atoi(remaining); err == nil {
 			c.rateLimitRemain = val
 		}
 	}
-	if reset := resp.Header.Get("X-Rate-Limit-Reset"); reset != "" {
+	if reset := firstHeader(h, rateLimitResetHeaders); reset != "" {
 		if val, err := strconv.ParseInt(reset, 10, 64); err == nil {
-			c.rateLimitReset = time.Unix(val, 0)
+			if val <= maxResetDelta {
+				c.rateLimitReset = time.Now().Add(time.Duration(val) * time.Second)
+			} else {
+				c.rateLimitReset = time.Unix(val, 0)
+			}
+		}
+	}
+	if retryAfter := strings.TrimSpace(h.Get(rateLimitRetryAfterHeader)); retryAfter != "" {
+		if val, err := strconv.ParseInt(retryAfter, 10, 64); err == nil {
+			c.rateLimitRemain = 0
+			c.rateLimitReset = time.Now().Add(time.Duration(val) * time.Second)
 		}
 	}
+}
 
-	return resp, nil
+// firstHeader returns the value of the first of names present in h.
+func firstHeader(h http.Header, names []string) string {
+	for _, name := range names {
+		if v := strings.TrimSpace(h.Get(name)); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// waitForRateLimit blocks until the rate limit resets if no requests remain.
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	c.rateLimitMu.Lock()
+	remaining, reset := c.rateLimitRemain, c.rateLimitReset
+	c.rateLimitMu.Unlock()
+
+	if remaining > 0 || !time.Now().Before(reset) {
+		return nil
+	}
+
+	timer := time.NewTimer(time.Until(reset))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// cancelOnClose cancels a request context once the response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// logDebug logs a debug message if a logger is configured.
+func (c *Client) logDebug(ctx context.Context, msg string, args ...any) {
+	if c.logger == nil {
+		return
+	}
+	c.logger.DebugContext(ctx, msg, args...)
+}
+
+// redactHeaders returns a copy of the headers suitable for logging, with
+// sensitive values replaced.
+func redactHeaders(h http.Header) map[string]string {
+	redacted := make(map[string]string, len(h))
+	for key := range h {
+		switch http.CanonicalHeaderKey(key) {
+		case "Authorization", "Cookie", "Proxy-Authorization":
+			redacted[key] = "[REDACTED]"
+		default:
+			redacted[key] = h.Get(key)
+		}
+	}
+	return redacted
 }
 
 // doRequest performs an authenticated HTTP request.
@@ -210,6 +678,14 @@ func (c *Client) doRequest(ctx context.Context, method, endpoint string, body an
 	return c.doRequestWithHeaders(ctx, method, endpoint, body, nil)
 }
 
+// Do sends an authenticated request for endpoints not covered by the typed API.
+// The endpoint may be a path relative to the base URL or an absolute HAL link.
+// Authentication, retries, rate limiting and caching apply as for other calls;
+// the caller must close the response body.
+func (c *Client) Do(ctx context.Context, method, endpoint string, body any, headers map[string]string) (*http.Response, error) {
+	return c.doRequestWithHeaders(ctx, method, endpoint, body, headers)
+}
+
 // Response represents a generic API response.
 type Response struct {
 	Success          bool   `json:"success"`
@@ -217,6 +693,16 @@ type Response struct {
 	ErrorID          string `json:"errorId,omitempty"`
 }
 
+// StatusError is returned when the API responds with a non-2xx status.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
 // parseResponse reads and parses the API response.
 func parseResponse(resp *http.Response, v any) error {
 	defer func() {
@@ -228,7 +714,7 @@ func parseResponse(resp *http.Response, v any) error {
 		if err != nil {
 			return fmt.Errorf("request failed with status %d: %w", resp.StatusCode, err)
 		}
-		return fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(body))
+		return &StatusError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
 	if v != nil {
@@ -242,6 +728,9 @@ func parseResponse(resp *http.Response, v any) error {
 
 // GetRateLimitInfo returns the current rate limit status.
 func (c *Client) GetRateLimitInfo() (remaining int, reset time.Time) {
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+
 	return c.rateLimitRemain, c.rateLimitReset
 }
 
@@ -249,3 +738,68 @@ func (c *Client) GetRateLimitInfo() (remaining int, reset time.Time) {
 func (c *Client) GetTenantID() string {
 	return c.tenantID
 }
+
+// followLink GETs the resource behind a link relation and decodes it into out.
+// Checking the response status is left to the caller.
+func (c *Client) followLink(ctx context.Context, links HALLinks, rel string, out any) error {
+	href, ok := links.Href(rel)
+	if !ok {
+		return fmt.Errorf("no %s link", rel)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, href, nil)
+	if err != nil {
+		return fmt.Errorf("following %s link: %w", rel, err)
+	}
+
+	if err := parseResponse(resp, out); err != nil {
+		return fmt.Errorf("parsing %s link response: %w", rel, err)
+	}
+
+	return nil
+}
+
+// PageInfo describes one page of a paginated list response.
+type PageInfo struct {
+	Size          int `json:"size"`
+	TotalElements int `json:"totalElements"`
+	TotalPages    int `json:"totalPages"`
+	Number        int `json:"number"`
+}
+
+// HALLinks holds the _links object of a HAL+JSON resource.
+type HALLinks map[string]interface{}
+
+// Href returns the href of a link relation. It is false if the relation is
+// missing or not a single link object.
+func (l HALLinks) Href(rel string) (string, bool) {
+	link, ok := l[rel].(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+
+	href, ok := link["href"].(string)
+	if !ok || href == "" {
+		return "", false
+	}
+
+	return href, true
+}
+
+// Hrefs returns the hrefs of a link relation that holds one link or an array
+// of links.
+func (l HALLinks) Hrefs(rel string) []string {
+	if href, ok := l.Href(rel); ok {
+		return []string{href}
+	}
+
+	links, _ := l[rel].([]interface{})
+	var hrefs []string
+	for _, link := range links {
+		link, _ := link.(map[string]interface{})
+		if href, _ := link["href"].(string); href != "" {
+			hrefs = append(hrefs, href)
+		}
+	}
+	return hrefs
+}
@@ -5,8 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -119,6 +122,406 @@ func TestClient_authenticate(t *testing.T) {
 	}
 }
 
+func TestClient_Scope(t *testing.T) {
+	var forms []map[string][]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		forms = append(forms, r.PostForm)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "test-token",
+			"expires_in":   3600,
+		})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithAuthURL(server.URL), WithScope("cloudprint"))
+	require.NoError(t, client.authenticate(context.Background()))
+
+	client = New("test-id", "test-secret", WithAuthURL(server.URL))
+	require.NoError(t, client.authenticate(context.Background()))
+
+	require.Len(t, forms, 2)
+	assert.Equal(t, []string{"cloudprint"}, forms[0]["scope"])
+	assert.NotContains(t, forms[1], "scope")
+}
+
+func TestClient_Locale(t *testing.T) {
+	var got []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+		got = append(got, r.Header.Get("Accept-Language"))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithLocale("de-DE"))
+	_, err := client.doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+	require.NoError(t, err)
+
+	client = New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"))
+	_, err = client.doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"de-DE", ""}, got)
+}
+
+func TestClient_UserAgent(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []Option
+		wantAgent string
+	}{
+		{
+			name:      "default user agent",
+			wantAgent: defaultUserAgent,
+		},
+		{
+			name:      "custom user agent",
+			opts:      []Option{WithUserAgent("my-app/2.0")},
+			wantAgent: "my-app/2.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.wantAgent, r.Header.Get("User-Agent"))
+
+				switch r.URL.Path {
+				case "/oauth/token":
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{
+						"access_token": "test-token",
+						"expires_in":   3600,
+					})
+				case "/upload":
+					w.WriteHeader(http.StatusCreated)
+				default:
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{
+						"success": true,
+					})
+				}
+			}))
+			defer server.Close()
+
+			opts := append([]Option{WithBaseURL(server.URL), WithAuthURL(server.URL + "/oauth/token")}, tt.opts...)
+			client := New("test-id", "test-secret", opts...)
+
+			_, err := client.GetTenants(context.Background())
+			require.NoError(t, err)
+
+			err = client.UploadDocument(context.Background(), server.URL+"/upload", nil, []byte("data"))
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestClient_Logger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "secret-token",
+				"expires_in":   3600,
+			})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+			})
+		}
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithLogger(logger))
+	_, err := client.GetTenants(context.Background())
+	require.NoError(t, err)
+
+	output := buf.String()
+	assert.Contains(t, output, "printix token request")
+	assert.Contains(t, output, "printix request")
+	assert.Contains(t, output, "method=GET")
+	assert.Contains(t, output, "status=200")
+	assert.Contains(t, output, "[REDACTED]")
+	assert.NotContains(t, output, "secret-token")
+	assert.NotContains(t, output, "test-secret")
+}
+
+func TestClient_RequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/slow/jobs":
+			time.Sleep(200 * time.Millisecond)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithRequestTimeout(50*time.Millisecond))
+
+	// The response body must remain readable after doRequest returns.
+	_, err := client.GetTenants(context.Background())
+	require.NoError(t, err)
+
+	client.SetTenant("slow")
+	_, err = client.GetJobs(context.Background(), nil)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClient_UploadTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/upload":
+			time.Sleep(200 * time.Millisecond)
+			w.WriteHeader(http.StatusCreated)
+		default:
+			// Slower than the upload timeout, but API calls are not bound by it
+			time.Sleep(100 * time.Millisecond)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"),
+		WithUploadTimeout(50*time.Millisecond), WithMaxRetries(0))
+
+	_, err := client.GetTenants(context.Background())
+	require.NoError(t, err)
+
+	err = client.UploadDocument(context.Background(), server.URL+"/upload", nil, []byte("%PDF-1.4"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Client.Timeout exceeded")
+}
+
+func TestClient_RateLimitGuard(t *testing.T) {
+	reset := time.Now().Add(2 * time.Second)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		default:
+			w.Header().Set("X-Rate-Limit-Remaining", "0")
+			w.Header().Set("X-Rate-Limit-Reset", strconv.FormatInt(reset.Unix(), 10))
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithRateLimitGuard())
+
+	_, err := client.GetTenants(context.Background())
+	require.NoError(t, err)
+
+	remaining, gotReset := client.GetRateLimitInfo()
+	assert.Equal(t, 0, remaining)
+	assert.Equal(t, reset.Unix(), gotReset.Unix())
+
+	// The next request must wait for the reset and honor the context deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = client.GetTenants(ctx)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "waiting for rate limit reset")
+}
+
+func TestClient_RateLimitGuard_RetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		default:
+			w.Header().Set("X-Rate-Limit-Retry-After-Seconds", "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithRateLimitGuard())
+
+	_, err := client.GetTenants(context.Background())
+	require.Error(t, err)
+
+	remaining, reset := client.GetRateLimitInfo()
+	assert.Equal(t, 0, remaining)
+	assert.WithinDuration(t, time.Now().Add(2*time.Second), reset, time.Second)
+
+	// The next request must wait for the retry-after period
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = client.GetTenants(ctx)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "waiting for rate limit reset")
+}
+
+func TestClient_Close(t *testing.T) {
+	var tokenRequests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			tokenRequests++
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"))
+
+	_, err := client.doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+	require.NoError(t, err)
+
+	client.Close()
+	assert.Empty(t, client.currentToken())
+
+	_, err = client.doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, tokenRequests)
+}
+
+func TestClient_Do(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+
+		assert.Equal(t, "/cloudprint/tenants/test-tenant/sites", r.URL.Path)
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "abc-123", r.Header.Get("X-Correlation-ID"))
+
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "HQ", body["name"])
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"))
+
+	resp, err := client.Do(context.Background(), http.MethodPost, "/cloudprint/tenants/test-tenant/sites",
+		map[string]string{"name": "HQ"}, map[string]string{"X-Correlation-ID": "abc-123"})
+	require.NoError(t, err)
+
+	var result Response
+	require.NoError(t, parseResponse(resp, &result))
+	assert.True(t, result.Success)
+}
+
+func TestClient_CorrelationID(t *testing.T) {
+	type ctxKey struct{}
+	var got []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+		got = append(got, strings.Join(r.Header.Values("X-Correlation-ID"), ","))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"),
+		WithCorrelationIDFunc(func(ctx context.Context) string {
+			id, _ := ctx.Value(ctxKey{}).(string)
+			return id
+		}))
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "req-42")
+	_, err := client.doRequest(ctx, http.MethodGet, "/cloudprint", nil)
+	require.NoError(t, err)
+
+	_, err = client.doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"req-42", ""}, got)
+}
+
+func TestClient_ReauthenticateOn401(t *testing.T) {
+	var tokenRequests, apiRequests int
+	validToken := "fresh-token"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			tokenRequests++
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "fresh-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+		apiRequests++
+		if r.Header.Get("Authorization") != "Bearer "+validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"),
+		WithAccessToken("stale-token", time.Now().Add(time.Hour)))
+
+	resp, err := client.doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 1, tokenRequests)
+	assert.Equal(t, 2, apiRequests)
+
+	// A token the server keeps rejecting is refreshed only once per request
+	validToken = "never-valid"
+	resp, err = client.doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, 2, tokenRequests)
+	assert.Equal(t, 4, apiRequests)
+}
+
 func TestParseResponse(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -178,3 +581,103 @@ func makeBody(v interface{}) io.ReadCloser {
 	}
 	return io.NopCloser(&buf)
 }
+
+func TestHALLinks(t *testing.T) {
+	var printer Printer
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "printer-1",
+		"_links": {
+			"self": {"href": "https://api.printix.net/cloudprint/tenants/t/printers/printer-1"},
+			"jobs": [{"href": "/jobs/1"}, {"href": "/jobs/2"}],
+			"broken": {"title": "no href"}
+		}
+	}`), &printer))
+
+	href, ok := printer.Links.Href("self")
+	assert.True(t, ok)
+	assert.Equal(t, "https://api.printix.net/cloudprint/tenants/t/printers/printer-1", href)
+
+	_, ok = printer.Links.Href("broken")
+	assert.False(t, ok)
+	_, ok = printer.Links.Href("missing")
+	assert.False(t, ok)
+
+	assert.Equal(t, []string{"/jobs/1", "/jobs/2"}, printer.Links.Hrefs("jobs"))
+	assert.Equal(t, []string{href}, printer.Links.Hrefs("self"))
+	assert.Empty(t, HALLinks(nil).Hrefs("self"))
+}
+
+func TestClient_apiURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		baseURL  string
+		basePath string
+		endpoint string
+		expected string
+	}{
+		{"default", "https://api.printix.net", "", "/cloudprint", "https://api.printix.net/cloudprint"},
+		{"base path", "https://proxy.example.com", "/printix", "/cloudprint", "https://proxy.example.com/printix/cloudprint"},
+		{"slashes trimmed", "https://proxy.example.com/", "/printix/", "cloudprint", "https://proxy.example.com/printix/cloudprint"},
+		{"bare prefix", "https://proxy.example.com", "printix", "/cloudprint", "https://proxy.example.com/printix/cloudprint"},
+		{"absolute link", "https://proxy.example.com", "/printix", "https://api.printix.net/cloudprint/jobs/1", "https://api.printix.net/cloudprint/jobs/1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := New("test-id", "test-secret", WithBaseURL(tt.baseURL), WithBasePath(tt.basePath))
+			assert.Equal(t, tt.expected, client.apiURL(tt.endpoint))
+		})
+	}
+}
+
+func TestClient_updateRateLimit(t *testing.T) {
+	epoch := time.Now().Add(time.Minute).Unix()
+
+	tests := []struct {
+		name          string
+		headers       map[string]string
+		wantRemaining int
+		wantReset     time.Duration
+	}{
+		{
+			name:          "X-Rate-Limit with epoch reset",
+			headers:       map[string]string{"X-Rate-Limit-Remaining": "7", "X-Rate-Limit-Reset": strconv.FormatInt(epoch, 10)},
+			wantRemaining: 7,
+			wantReset:     time.Minute,
+		},
+		{
+			name:          "X-RateLimit with epoch reset",
+			headers:       map[string]string{"X-RateLimit-Remaining": "5", "X-RateLimit-Reset": strconv.FormatInt(epoch, 10)},
+			wantRemaining: 5,
+			wantReset:     time.Minute,
+		},
+		{
+			name:          "RFC RateLimit with delta reset",
+			headers:       map[string]string{"RateLimit-Remaining": "3", "RateLimit-Reset": "30"},
+			wantRemaining: 3,
+			wantReset:     30 * time.Second,
+		},
+		{
+			name:          "lowercase header names",
+			headers:       map[string]string{"x-ratelimit-remaining": "2", "x-ratelimit-reset": "10"},
+			wantRemaining: 2,
+			wantReset:     10 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := New("test-id", "test-secret")
+			h := http.Header{}
+			for k, v := range tt.headers {
+				h.Set(k, v)
+			}
+
+			client.updateRateLimit(h)
+
+			remaining, reset := client.GetRateLimitInfo()
+			assert.Equal(t, tt.wantRemaining, remaining)
+			assert.WithinDuration(t, time.Now().Add(tt.wantReset), reset, 2*time.Second)
+		})
+	}
+}
@@ -19,6 +19,6 @@
 //   - Managing printers
 //   - Tracking job status
 //
-// For ZPL label printing, the content type should be set appropriately,
-// though full ZPL support depends on the printer capabilities.
+// For ZPL label printing use Client.PrintZPL, which sets the PDL and upload
+// content type. Full ZPL support depends on the printer capabilities.
 package printix
@@ -0,0 +1,52 @@
+package printix
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// dryRunBody is the response body synthesized for stubbed requests.
+const dryRunBody = `{"success":true}`
+
+// WithDryRun stubs all mutating requests. POST, PUT, PATCH and DELETE API
+// calls are not sent and return a successful response without content, and
+// document uploads return nil without transferring data. Print helpers such
+// as PrintFile stop after the stubbed submission. GET requests, token
+// requests and VerifyWebhookEndpoint still reach the network. Stubbed
+// requests are logged at info level when a logger is set.
+func WithDryRun() Option {
+	return func(c *Client) {
+		c.dryRun = true
+	}
+}
+
+// isMutating reports whether a request with method changes server state.
+func isMutating(method string) bool {
+	return method != http.MethodGet && method != http.MethodHead
+}
+
+// dryRunResponse logs a stubbed request and returns a synthesized success.
+func (c *Client) dryRunResponse(ctx context.Context, method, fullURL string) *http.Response {
+	c.logDryRun(ctx, method, fullURL)
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(strings.NewReader(dryRunBody)),
+		ContentLength: int64(len(dryRunBody)),
+	}
+}
+
+// logDryRun logs a request skipped in dry-run mode.
+func (c *Client) logDryRun(ctx context.Context, method, fullURL string) {
+	if c.logger == nil {
+		return
+	}
+	c.logger.InfoContext(ctx, "printix dry run: request not sent", "method", method, "url", fullURL)
+}
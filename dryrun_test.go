@@ -0,0 +1,50 @@
+package printix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_DryRun(t *testing.T) {
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"job":     map[string]interface{}{"id": "job-1", "status": "completed"},
+		})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"),
+		WithTenantID("test-tenant"), WithDryRun())
+
+	require.NoError(t, client.DeleteJob(context.Background(), "job-1"))
+	require.NoError(t, client.UploadDocument(context.Background(), server.URL+"/upload", nil, []byte("%PDF")))
+
+	path := filepath.Join(t.TempDir(), "doc.pdf")
+	require.NoError(t, os.WriteFile(path, []byte("%PDF-1.4"), 0o600))
+	require.NoError(t, client.PrintFile(context.Background(), "printer-1", "Doc", path, nil))
+	assert.Empty(t, requests)
+
+	job, err := client.GetJob(context.Background(), "job-1")
+	require.NoError(t, err)
+	assert.Equal(t, "job-1", job.ID)
+	assert.Equal(t, []string{"POST /oauth/token", "GET /cloudprint/tenants/test-tenant/jobs/job-1"}, requests)
+}
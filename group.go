@@ -2,10 +2,14 @@ package printix
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
 // Group represents a Printix group.
@@ -22,13 +26,8 @@ type Group struct {
 // GroupsResponse represents the response from listing groups.
 type GroupsResponse struct {
 	Response
-	Groups []Group `json:"groups"`
-	Page   struct {
-		Size          int `json:"size"`
-		TotalElements int `json:"totalElements"`
-		TotalPages    int `json:"totalPages"`
-		Number        int `json:"number"`
-	} `json:"page"`
+	Groups []Group  `json:"groups"`
+	Page   PageInfo `json:"page"`
 }
 
 // GetGroupsOptions represents options for retrieving groups.
@@ -171,6 +170,38 @@ func (c *Client) UpdateGroup(ctx context.Context, groupID string, group *Group)
 	return &groupResp.Group, nil
 }
 
+// PatchGroup updates only the fields present in changes, keyed by their JSON
+// names, e.g. {"description": "Finance"}. The API has no partial update, so
+// the group is fetched, the changes are merged in and the result is sent with
+// UpdateGroup; fields left out such as members keep their current values.
+func (c *Client) PatchGroup(ctx context.Context, groupID string, changes map[string]any) (*Group, error) {
+	group, err := c.GetGroup(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("patching group: %w", err)
+	}
+
+	current, err := json.Marshal(group)
+	if err != nil {
+		return nil, fmt.Errorf("encoding group: %w", err)
+	}
+	fields := make(map[string]any)
+	if err := json.Unmarshal(current, &fields); err != nil {
+		return nil, fmt.Errorf("decoding group: %w", err)
+	}
+	maps.Copy(fields, changes)
+
+	merged, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("encoding group changes: %w", err)
+	}
+	var patched Group
+	if err := json.Unmarshal(merged, &patched); err != nil {
+		return nil, fmt.Errorf("applying group changes: %w", err)
+	}
+
+	return c.UpdateGroup(ctx, groupID, &patched)
+}
+
 // DeleteGroup deletes a group.
 func (c *Client) DeleteGroup(ctx context.Context, groupID string) error {
 	if c.tenantID == "" {
@@ -244,4 +275,57 @@ func (c *Client) RemoveGroupMember(ctx context.Context, groupID, userID string)
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// groupMemberConcurrency limits the number of concurrent membership changes.
+const groupMemberConcurrency = 4
+
+// AddGroupMembers adds several users to a group. The API has no batch endpoint,
+// so users are added concurrently; failures are reported per user.
+func (c *Client) AddGroupMembers(ctx context.Context, groupID string, userIDs []string) error {
+	return c.forEachGroupMember(ctx, userIDs, func(userID string) error {
+		return c.AddGroupMember(ctx, groupID, userID)
+	})
+}
+
+// RemoveGroupMembers removes several users from a group. The API has no batch
+// endpoint, so users are removed concurrently; failures are reported per user.
+func (c *Client) RemoveGroupMembers(ctx context.Context, groupID string, userIDs []string) error {
+	return c.forEachGroupMember(ctx, userIDs, func(userID string) error {
+		return c.RemoveGroupMember(ctx, groupID, userID)
+	})
+}
+
+// forEachGroupMember runs fn for each user with bounded concurrency. Users not
+// yet started when ctx is done are skipped with the context error.
+func (c *Client) forEachGroupMember(ctx context.Context, userIDs []string, fn func(userID string) error) error {
+	errs := make([]error, len(userIDs))
+	sem := make(chan struct{}, groupMemberConcurrency)
+
+	var wg sync.WaitGroup
+	for i, userID := range userIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[i] = fmt.Errorf("user %s: %w", userID, ctx.Err())
+				return
+			}
+
+			if err := ctx.Err(); err != nil {
+				errs[i] = fmt.Errorf("user %s: %w", userID, err)
+				return
+			}
+			if err := fn(userID); err != nil {
+				errs[i] = fmt.Errorf("user %s: %w", userID, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
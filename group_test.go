@@ -0,0 +1,110 @@
+package printix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_AddGroupMembers(t *testing.T) {
+	var mu sync.Mutex
+	added := map[string]string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+
+		userID := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		if userID == "user-2" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":          false,
+				"errorDescription": "User not found",
+				"errorId":          "ERR404",
+			})
+			return
+		}
+
+		mu.Lock()
+		added[userID] = r.Method
+		mu.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	err := client.AddGroupMembers(context.Background(), "group-1", []string{"user-1", "user-2", "user-3"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "user user-2")
+	assert.NotContains(t, err.Error(), "user user-1")
+	assert.Equal(t, map[string]string{"user-1": http.MethodPut, "user-3": http.MethodPut}, added)
+
+	err = client.RemoveGroupMembers(context.Background(), "group-1", []string{"user-1"})
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodDelete, added["user-1"])
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = client.AddGroupMembers(ctx, "group-1", []string{"user-4"})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.NotContains(t, added, "user-4")
+}
+
+func TestClient_PatchGroup(t *testing.T) {
+	var methods []string
+	var gotBody map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+
+		assert.Equal(t, "/cloudprint/tenants/test-tenant/groups/group-1", r.URL.Path)
+		methods = append(methods, r.Method)
+		group := map[string]interface{}{
+			"id":          "group-1",
+			"name":        "Finance",
+			"description": "Old description",
+			"members":     []string{"user-1"},
+		}
+		if r.Method == http.MethodPut {
+			_ = json.NewDecoder(r.Body).Decode(&gotBody)
+			group = gotBody
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"group":   group,
+		})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	group, err := client.PatchGroup(context.Background(), "group-1", map[string]any{"description": "Finance team"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{http.MethodGet, http.MethodPut}, methods)
+	assert.Equal(t, map[string]interface{}{
+		"id":          "group-1",
+		"name":        "Finance",
+		"description": "Finance team",
+		"members":     []interface{}{"user-1"},
+	}, gotBody)
+	assert.Equal(t, "Finance team", group.Description)
+	assert.Equal(t, []string{"user-1"}, group.Members)
+}
@@ -2,10 +2,14 @@ package printix
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // Job represents a print job.
@@ -21,42 +25,91 @@ type Job struct {
 	UserID      string         `json:"userId,omitempty"`
 	UserName    string         `json:"userName,omitempty"`
 	Properties  map[string]any `json:"properties,omitempty"`
+
+	// NormalizedStatus is Status in canonical form, see NormalizeJobStatus.
+	NormalizedStatus JobStatus `json:"-"`
+}
+
+// UnmarshalJSON decodes a job and normalizes its status.
+func (j *Job) UnmarshalJSON(data []byte) error {
+	type rawJob Job
+	if err := json.Unmarshal(data, (*rawJob)(j)); err != nil {
+		return err
+	}
+	j.NormalizedStatus = NormalizeJobStatus(j.Status)
+	return nil
 }
 
 // JobsResponse represents the response from listing jobs.
 type JobsResponse struct {
 	Response
-	Jobs []Job `json:"jobs"`
+	Links HALLinks `json:"_links,omitempty"`
+	Jobs  []Job    `json:"jobs"`
+	Page  PageInfo `json:"page"`
 }
 
+// JobStatus is a job status in canonical lowercase form.
+type JobStatus string
+
 // JobStatus represents possible job statuses.
 const (
-	JobStatusPending    = "pending"
-	JobStatusProcessing = "processing"
-	JobStatusPrinting   = "printing"
-	JobStatusCompleted  = "completed"
-	JobStatusFailed     = "failed"
-	JobStatusCancelled  = "cancelled"
+	JobStatusCreated    JobStatus = "created"
+	JobStatusPending    JobStatus = "pending"
+	JobStatusProcessing JobStatus = "processing"
+	JobStatusPrinting   JobStatus = "printing"
+	JobStatusCompleted  JobStatus = "completed"
+	JobStatusFailed     JobStatus = "failed"
+	JobStatusCancelled  JobStatus = "cancelled"
 )
 
 // GetJobsOptions represents options for retrieving jobs.
 type GetJobsOptions struct {
 	PrinterID string
 	UserID    string
-	Status    string
+	Status    JobStatus
 	Limit     int
 	Offset    int
+	// From and To limit the jobs to a creation time range. Zero values are
+	// not sent.
+	From time.Time
+	To   time.Time
+	// Cursor is a next link returned by GetJobsCursor. When set, the other
+	// options are ignored because the link already carries them.
+	Cursor string
 }
 
 // GetJobs retrieves print jobs based on the provided options.
 func (c *Client) GetJobs(ctx context.Context, opts *GetJobsOptions) ([]Job, error) {
+	jobsResp, err := c.GetJobsPage(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return jobsResp.Jobs, nil
+}
+
+// GetJobsWithPage retrieves print jobs and the pagination information, e.g.
+// the total number of jobs matching the options.
+func (c *Client) GetJobsWithPage(ctx context.Context, opts *GetJobsOptions) ([]Job, *PageInfo, error) {
+	jobsResp, err := c.GetJobsPage(ctx, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return jobsResp.Jobs, &jobsResp.Page, nil
+}
+
+// GetJobsPage retrieves print jobs along with the pagination information.
+func (c *Client) GetJobsPage(ctx context.Context, opts *GetJobsOptions) (*JobsResponse, error) {
 	if c.tenantID == "" {
 		return nil, fmt.Errorf("tenant ID is required for getting jobs")
 	}
 
 	endpoint := fmt.Sprintf(jobsEndpoint, c.tenantID)
 
-	if opts != nil {
+	if opts != nil && opts.Cursor != "" {
+		endpoint = opts.Cursor
+	} else if opts != nil {
 		params := url.Values{}
 		if opts.PrinterID != "" {
 			params.Set("printerId", opts.PrinterID)
@@ -65,7 +118,7 @@ func (c *Client) GetJobs(ctx context.Context, opts *GetJobsOptions) ([]Job, erro
 			params.Set("userId", opts.UserID)
 		}
 		if opts.Status != "" {
-			params.Set("status", opts.Status)
+			params.Set("status", string(opts.Status))
 		}
 		if opts.Limit > 0 {
 			params.Set("limit", strconv.Itoa(opts.Limit))
@@ -73,6 +126,12 @@ func (c *Client) GetJobs(ctx context.Context, opts *GetJobsOptions) ([]Job, erro
 		if opts.Offset > 0 {
 			params.Set("offset", strconv.Itoa(opts.Offset))
 		}
+		if !opts.From.IsZero() {
+			params.Set("from", opts.From.Format(time.RFC3339))
+		}
+		if !opts.To.IsZero() {
+			params.Set("to", opts.To.Format(time.RFC3339))
+		}
 
 		if len(params) > 0 {
 			endpoint += "?" + params.Encode()
@@ -93,7 +152,57 @@ func (c *Client) GetJobs(ctx context.Context, opts *GetJobsOptions) ([]Job, erro
 		return nil, fmt.Errorf("get jobs failed: %s (error ID: %s)", jobsResp.ErrorDescription, jobsResp.ErrorID)
 	}
 
-	return jobsResp.Jobs, nil
+	return &jobsResp, nil
+}
+
+// GetAllJobs retrieves all jobs matching the options by automatically
+// advancing the offset until the reported total has been fetched, or, if the
+// server reports no total, until a short page is returned. If opts carries a
+// Cursor, the listing continues from it as in GetAllJobsCursor.
+func (c *Client) GetAllJobs(ctx context.Context, opts *GetJobsOptions) ([]Job, error) {
+	// Cursor pages ignore the offset, so follow their next links instead
+	if opts != nil && opts.Cursor != "" {
+		return c.GetAllJobsCursor(ctx, opts)
+	}
+
+	pageOpts := GetJobsOptions{Limit: 100}
+	if opts != nil {
+		pageOpts = *opts
+		if pageOpts.Limit <= 0 {
+			pageOpts.Limit = 100
+		}
+	}
+
+	var allJobs []Job
+	for {
+		resp, err := c.GetJobsPage(ctx, &pageOpts)
+		if err != nil {
+			return nil, fmt.Errorf("getting jobs at offset %d: %w", pageOpts.Offset, err)
+		}
+
+		allJobs = append(allJobs, resp.Jobs...)
+		pageOpts.Offset += len(resp.Jobs)
+
+		// The server may cap the page size, so a short page only ends the
+		// listing when no total is reported
+		if len(resp.Jobs) == 0 {
+			break
+		}
+		if resp.Page.TotalElements > 0 {
+			if pageOpts.Offset >= resp.Page.TotalElements {
+				break
+			}
+		} else if len(resp.Jobs) < pageOpts.Limit {
+			break
+		}
+	}
+
+	return allJobs, nil
+}
+
+// GetJobHistory retrieves all jobs created between from and to.
+func (c *Client) GetJobHistory(ctx context.Context, from, to time.Time) ([]Job, error) {
+	return c.GetAllJobs(ctx, &GetJobsOptions{From: from, To: to})
 }
 
 // GetJob retrieves details for a specific job.
@@ -125,6 +234,77 @@ func (c *Client) GetJob(ctx context.Context, jobID string) (*Job, error) {
 	return &jobResp.Job, nil
 }
 
+// WatchJob fetches a job and then polls it until it reaches a terminal
+// status. The returned channel receives the job initially and after every
+// status change, and is closed once the job is terminal or ctx is done. If
+// polling fails with a client error, e.g. because the job was deleted, the
+// channel is closed without the job reaching a terminal status; other poll
+// errors are retried. The poll interval defaults to 5 seconds and is set with
+// WithJobPollInterval.
+func (c *Client) WatchJob(ctx context.Context, jobID string) (<-chan Job, error) {
+	job, err := c.GetJob(ctx, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("watching job: %w", err)
+	}
+
+	ch := make(chan Job, 1)
+	ch <- *job
+
+	go c.watchJob(ctx, *job, ch)
+
+	return ch, nil
+}
+
+// watchJob polls the job and sends status changes to ch until it is terminal.
+func (c *Client) watchJob(ctx context.Context, last Job, ch chan<- Job) {
+	defer close(ch)
+
+	ticker := time.NewTicker(c.jobPollInterval)
+	defer ticker.Stop()
+
+	for !IsTerminalStatus(last.NormalizedStatus) {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		job, err := c.GetJob(ctx, last.ID)
+		if err != nil {
+			c.logDebug(ctx, "printix job watch poll failed", "job_id", last.ID, "error", err)
+			if isClientError(err) {
+				return
+			}
+			continue
+		}
+		if job.Status == last.Status {
+			continue
+		}
+
+		select {
+		case ch <- *job:
+		case <-ctx.Done():
+			return
+		}
+		last = *job
+	}
+}
+
+// isClientError reports whether err is a 4xx response that will not succeed
+// on retry. Throttling and request timeouts are not client errors.
+func isClientError(err error) bool {
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		return false
+	}
+
+	switch statusErr.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return false
+	}
+	return statusErr.StatusCode >= 400 && statusErr.StatusCode < 500
+}
+
 // CancelJob cancels a print job.
 func (c *Client) CancelJob(ctx context.Context, jobID string) error {
 	if c.tenantID == "" {
@@ -150,6 +330,107 @@ func (c *Client) CancelJob(ctx context.Context, jobID string) error {
 	return nil
 }
 
+// GetJobsCursor retrieves a page of jobs and returns the HAL next link as an
+// opaque cursor. Pass the cursor in GetJobsOptions.Cursor to fetch the next
+// page; an empty cursor means there are no more pages.
+func (c *Client) GetJobsCursor(ctx context.Context, opts *GetJobsOptions) ([]Job, string, error) {
+	jobsResp, err := c.GetJobsPage(ctx, opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextCursor, _ := jobsResp.Links.Href("next")
+	return jobsResp.Jobs, nextCursor, nil
+}
+
+// GetAllJobsCursor retrieves all jobs matching the options by following the
+// HAL next links until the last page.
+func (c *Client) GetAllJobsCursor(ctx context.Context, opts *GetJobsOptions) ([]Job, error) {
+	pageOpts := GetJobsOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	var allJobs []Job
+	for {
+		jobs, nextCursor, err := c.GetJobsCursor(ctx, &pageOpts)
+		if err != nil {
+			return nil, fmt.Errorf("getting jobs page: %w", err)
+		}
+
+		allJobs = append(allJobs, jobs...)
+		if nextCursor == "" {
+			break
+		}
+		pageOpts.Cursor = nextCursor
+	}
+
+	return allJobs, nil
+}
+
+// CancelAllJobs cancels all unfinished jobs of a printer. It continues when
+// individual cancellations fail and returns the number of cancelled jobs
+// together with the combined errors.
+func (c *Client) CancelAllJobs(ctx context.Context, printerID string) (int, error) {
+	jobs, err := c.GetAllJobs(ctx, &GetJobsOptions{PrinterID: printerID})
+	if err != nil {
+		return 0, fmt.Errorf("getting jobs for printer %s: %w", printerID, err)
+	}
+
+	cancelled := 0
+	var errs []error
+	for _, job := range jobs {
+		if IsTerminalStatus(job.NormalizedStatus) {
+			continue
+		}
+
+		if err := c.CancelJob(ctx, job.ID); err != nil {
+			errs = append(errs, fmt.Errorf("job %s: %w", job.ID, err))
+			continue
+		}
+		cancelled++
+	}
+
+	return cancelled, errors.Join(errs...)
+}
+
+// jobStatusSpellings maps alternative server spellings to canonical statuses.
+var jobStatusSpellings = map[string]JobStatus{
+	"canceled": JobStatusCancelled,
+}
+
+// NormalizeJobStatus converts a status as reported by the API, e.g. "Created"
+// from Submit, to its canonical form so it can be compared with the JobStatus
+// constants. Unknown statuses are returned lowercased.
+func NormalizeJobStatus(raw string) JobStatus {
+	status := strings.ToLower(strings.TrimSpace(raw))
+	if canonical, ok := jobStatusSpellings[status]; ok {
+		return canonical
+	}
+	return JobStatus(status)
+}
+
+// IsTerminalStatus reports whether a job with the given status can no longer
+// change, i.e. it completed, failed or was cancelled. Case is ignored, as the
+// API reports e.g. "Created" on submit but lowercase statuses elsewhere.
+func IsTerminalStatus(status JobStatus) bool {
+	switch NormalizeJobStatus(string(status)) {
+	case JobStatusCompleted, JobStatusFailed, JobStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// IsActiveStatus reports whether a job with the given status is still on its
+// way to the printer. Case is ignored.
+func IsActiveStatus(status JobStatus) bool {
+	switch NormalizeJobStatus(string(status)) {
+	case JobStatusCreated, JobStatusPending, JobStatusProcessing, JobStatusPrinting:
+		return true
+	}
+	return false
+}
+
 // DeleteJob deletes a print job.
 func (c *Client) DeleteJob(ctx context.Context, jobID string) error {
 	if c.tenantID == "" {
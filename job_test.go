@@ -0,0 +1,376 @@
+package printix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetAllJobs(t *testing.T) {
+	const totalJobs = 5
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/jobs":
+			assert.Equal(t, "printer-1", r.URL.Query().Get("printerId"))
+			limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+			jobs := []map[string]interface{}{}
+			for i := offset; i < totalJobs && i < offset+limit; i++ {
+				jobs = append(jobs, map[string]interface{}{"id": fmt.Sprintf("job-%d", i)})
+			}
+
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+				"jobs":    jobs,
+				"page":    map[string]interface{}{"size": limit, "totalElements": totalJobs},
+			})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	opts := &GetJobsOptions{PrinterID: "printer-1", Limit: 2}
+	jobs, err := client.GetAllJobs(context.Background(), opts)
+	require.NoError(t, err)
+	require.Len(t, jobs, totalJobs)
+	assert.Equal(t, "job-0", jobs[0].ID)
+	assert.Equal(t, "job-4", jobs[4].ID)
+	assert.Equal(t, 0, opts.Offset, "caller options must not be modified")
+
+	page, err := client.GetJobsPage(context.Background(), opts)
+	require.NoError(t, err)
+	assert.Equal(t, totalJobs, page.Page.TotalElements)
+	assert.Len(t, page.Jobs, 2)
+
+	pageJobs, info, err := client.GetJobsWithPage(context.Background(), opts)
+	require.NoError(t, err)
+	assert.Len(t, pageJobs, 2)
+	assert.Equal(t, PageInfo{Size: 2, TotalElements: totalJobs}, *info)
+}
+
+func TestClient_GetAllJobs_CappedPageSize(t *testing.T) {
+	const totalJobs, maxPageSize = 120, 50
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/jobs":
+			requests++
+			limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			limit = min(limit, maxPageSize)
+
+			jobs := []map[string]interface{}{}
+			for i := offset; i < totalJobs && i < offset+limit; i++ {
+				jobs = append(jobs, map[string]interface{}{"id": fmt.Sprintf("job-%d", i)})
+			}
+
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+				"jobs":    jobs,
+				"page":    map[string]interface{}{"size": limit, "totalElements": totalJobs},
+			})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	jobs, err := client.GetAllJobs(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, jobs, totalJobs)
+	assert.Equal(t, "job-119", jobs[totalJobs-1].ID)
+	assert.Equal(t, 3, requests)
+}
+
+func TestClient_CancelAllJobs(t *testing.T) {
+	var cancelled []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/jobs":
+			assert.Equal(t, "printer-1", r.URL.Query().Get("printerId"))
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+				"jobs": []map[string]interface{}{
+					{"id": "job-1", "status": "pending"},
+					{"id": "job-2", "status": "completed"},
+					{"id": "job-3", "status": "printing"},
+					{"id": "job-4", "status": "processing"},
+				},
+			})
+		case "/cloudprint/tenants/test-tenant/jobs/job-3/cancel":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":          false,
+				"errorDescription": "Job already printing",
+				"errorId":          "ERR003",
+			})
+		default:
+			cancelled = append(cancelled, r.URL.Path)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	count, err := client.CancelAllJobs(context.Background(), "printer-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "job job-3")
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []string{
+		"/cloudprint/tenants/test-tenant/jobs/job-1/cancel",
+		"/cloudprint/tenants/test-tenant/jobs/job-4/cancel",
+	}, cancelled)
+}
+
+func TestClient_GetAllJobsCursor(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/jobs":
+			switch r.URL.Query().Get("cursor") {
+			case "":
+				assert.Equal(t, "user-1", r.URL.Query().Get("userId"))
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"success": true,
+					"jobs":    []map[string]interface{}{{"id": "job-1"}, {"id": "job-2"}},
+					"_links": map[string]interface{}{
+						"next": map[string]interface{}{"href": server.URL + "/cloudprint/tenants/test-tenant/jobs?cursor=abc"},
+					},
+				})
+			case "abc":
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"success": true,
+					"jobs":    []map[string]interface{}{{"id": "job-3"}},
+					"_links":  map[string]interface{}{},
+				})
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	jobs, cursor, err := client.GetJobsCursor(context.Background(), &GetJobsOptions{UserID: "user-1"})
+	require.NoError(t, err)
+	assert.Len(t, jobs, 2)
+	assert.Equal(t, server.URL+"/cloudprint/tenants/test-tenant/jobs?cursor=abc", cursor)
+
+	jobs, cursor, err = client.GetJobsCursor(context.Background(), &GetJobsOptions{Cursor: cursor})
+	require.NoError(t, err)
+	assert.Len(t, jobs, 1)
+	assert.Empty(t, cursor)
+
+	all, err := client.GetAllJobsCursor(context.Background(), &GetJobsOptions{UserID: "user-1"})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, "job-3", all[2].ID)
+
+	// GetAllJobs continues from a cursor instead of refetching the same page
+	all, err = client.GetAllJobs(context.Background(), &GetJobsOptions{Cursor: server.URL + "/cloudprint/tenants/test-tenant/jobs?cursor=abc", Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, "job-3", all[0].ID)
+}
+
+func TestJobStatusClassification(t *testing.T) {
+	tests := []struct {
+		status       JobStatus
+		wantTerminal bool
+		wantActive   bool
+	}{
+		{status: "Created", wantActive: true},
+		{status: JobStatusPending, wantActive: true},
+		{status: "PROCESSING", wantActive: true},
+		{status: JobStatusPrinting, wantActive: true},
+		{status: JobStatusCompleted, wantTerminal: true},
+		{status: "Failed", wantTerminal: true},
+		{status: JobStatusCancelled, wantTerminal: true},
+		{status: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			assert.Equal(t, tt.wantTerminal, IsTerminalStatus(tt.status))
+			assert.Equal(t, tt.wantActive, IsActiveStatus(tt.status))
+		})
+	}
+}
+
+func TestNormalizeJobStatus(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want JobStatus
+	}{
+		{raw: "Created", want: JobStatusCreated},
+		{raw: "Processing", want: JobStatusProcessing},
+		{raw: "pending", want: JobStatusPending},
+		{raw: " COMPLETED ", want: JobStatusCompleted},
+		{raw: "Canceled", want: JobStatusCancelled},
+		{raw: "cancelled", want: JobStatusCancelled},
+		{raw: "Held", want: "held"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizeJobStatus(tt.raw))
+		})
+	}
+
+	var job Job
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"job-1","status":"Processing"}`), &job))
+	assert.Equal(t, "Processing", job.Status)
+	assert.Equal(t, JobStatusProcessing, job.NormalizedStatus)
+
+	var submitResp SubmitResponse
+	submitResp.Job.Status = "Created"
+	assert.Equal(t, JobStatusCreated, submitResp.NormalizedJobStatus())
+}
+
+func TestClient_GetJobs_DateRange(t *testing.T) {
+	var queries []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+		queries = append(queries, r.URL.RawQuery)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "jobs": []interface{}{}})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	from := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 10, 0, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	_, err := client.GetJobs(context.Background(), &GetJobsOptions{UserID: "user-1", From: from, To: to})
+	require.NoError(t, err)
+	_, err = client.GetJobs(context.Background(), &GetJobsOptions{From: from})
+	require.NoError(t, err)
+	_, err = client.GetJobHistory(context.Background(), from, to)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"from=2024-06-03T00%3A00%3A00Z&to=2024-06-10T00%3A00%3A00%2B02%3A00&userId=user-1",
+		"from=2024-06-03T00%3A00%3A00Z",
+		"from=2024-06-03T00%3A00%3A00Z&limit=100&to=2024-06-10T00%3A00%3A00%2B02%3A00",
+	}, queries)
+}
+
+func TestClient_WatchJob(t *testing.T) {
+	statuses := []string{"created", "created", "printing", "completed"}
+	var polls int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+
+		status := statuses[min(polls, len(statuses)-1)]
+		polls++
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"job":     map[string]interface{}{"id": "job-1", "status": status},
+		})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"),
+		WithTenantID("test-tenant"), WithJobPollInterval(10*time.Millisecond))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	updates, err := client.WatchJob(ctx, "job-1")
+	require.NoError(t, err)
+
+	var got []string
+	for job := range updates {
+		got = append(got, job.Status)
+	}
+	assert.Equal(t, []string{"created", "printing", "completed"}, got)
+	require.NoError(t, ctx.Err())
+}
+
+func TestClient_WatchJob_Deleted(t *testing.T) {
+	var polls int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+
+		polls++
+		if polls > 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"job":     map[string]interface{}{"id": "job-1", "status": "created"},
+		})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"),
+		WithTenantID("test-tenant"), WithJobPollInterval(10*time.Millisecond))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	updates, err := client.WatchJob(ctx, "job-1")
+	require.NoError(t, err)
+
+	var got []string
+	for job := range updates {
+		got = append(got, job.Status)
+	}
+	assert.Equal(t, []string{"created"}, got)
+	assert.Equal(t, 2, polls)
+	require.NoError(t, ctx.Err())
+}
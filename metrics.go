@@ -0,0 +1,38 @@
+package printix
+
+import (
+	"net/http"
+	"time"
+)
+
+// MetricsObserver receives request and token metrics, e.g. to feed Prometheus
+// counters and histograms. Implementations must be safe for concurrent use.
+type MetricsObserver interface {
+	// ObserveRequest is called once per API call with the operation name,
+	// e.g. "Submit", the final HTTP status and the total duration including
+	// retries. The status is 0 if no response was received.
+	ObserveRequest(op string, status int, dur time.Duration)
+	// ObserveTokenRefresh is called after each access token request.
+	ObserveTokenRefresh(success bool)
+}
+
+// WithMetricsObserver reports request counts, latencies and token refreshes
+// to obs.
+func WithMetricsObserver(obs MetricsObserver) Option {
+	return func(c *Client) {
+		c.metrics = obs
+	}
+}
+
+// observeRequest reports a finished API call to the metrics observer.
+func (c *Client) observeRequest(op string, resp *http.Response, start time.Time) {
+	if c.metrics == nil {
+		return
+	}
+
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+	c.metrics.ObserveRequest(op, status, time.Since(start))
+}
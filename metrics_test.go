@@ -0,0 +1,89 @@
+package printix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedRequest struct {
+	op     string
+	status int
+}
+
+type recordingObserver struct {
+	mu       sync.Mutex
+	requests []recordedRequest
+	refresh  []bool
+}
+
+func (o *recordingObserver) ObserveRequest(op string, status int, _ time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.requests = append(o.requests, recordedRequest{op: op, status: status})
+}
+
+func (o *recordingObserver) ObserveTokenRefresh(success bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.refresh = append(o.refresh, success)
+}
+
+func TestClient_Metrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/jobs/missing":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+			})
+		}
+	}))
+	defer server.Close()
+
+	obs := &recordingObserver{}
+	client := New("test-id", "test-secret",
+		WithBaseURL(server.URL),
+		WithAuthURL(server.URL+"/oauth/token"),
+		WithTenantID("test-tenant"),
+		WithMetricsObserver(obs),
+	)
+
+	require.NoError(t, client.DeleteJob(context.Background(), "job-1"))
+	_, err := client.GetJob(context.Background(), "missing")
+	require.Error(t, err)
+
+	assert.Equal(t, []recordedRequest{
+		{op: "DeleteJob", status: http.StatusOK},
+		{op: "GetJob", status: http.StatusNotFound},
+	}, obs.requests)
+	assert.Equal(t, []bool{true}, obs.refresh)
+}
+
+func TestClient_MetricsTokenFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	obs := &recordingObserver{}
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL), WithMetricsObserver(obs))
+
+	_, err := client.doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+	require.Error(t, err)
+	assert.Equal(t, []bool{false}, obs.refresh)
+	assert.Equal(t, []recordedRequest{{op: "request", status: 0}}, obs.requests)
+}
@@ -0,0 +1,70 @@
+package printix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// PingErrorKind classifies a Ping failure.
+type PingErrorKind string
+
+// Ping failure kinds.
+const (
+	PingAuthFailed  PingErrorKind = "auth"
+	PingUnreachable PingErrorKind = "connectivity"
+)
+
+// PingError is returned by Ping when the API cannot be used.
+type PingError struct {
+	Kind PingErrorKind
+	Err  error
+}
+
+func (e *PingError) Error() string {
+	return fmt.Sprintf("printix ping failed (%s): %v", e.Kind, e.Err)
+}
+
+func (e *PingError) Unwrap() error {
+	return e.Err
+}
+
+// Ping verifies credentials and connectivity by requesting the API entry
+// point. It bypasses retries and the response cache, and reuses a valid
+// cached token like any other request. Failures are reported as *PingError.
+func (c *Client) Ping(ctx context.Context) error {
+	if err := c.authenticate(ctx); err != nil {
+		var netErr net.Error
+		if errors.As(err, &netErr) {
+			return &PingError{Kind: PingUnreachable, Err: err}
+		}
+		return &PingError{Kind: PingAuthFailed, Err: err}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.apiURL("/cloudprint"), nil)
+	if err != nil {
+		return fmt.Errorf("creating ping request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.currentToken())
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return &PingError{Kind: PingUnreachable, Err: err}
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return &PingError{Kind: PingAuthFailed, Err: fmt.Errorf("API responded with status %d", resp.StatusCode)}
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return &PingError{Kind: PingUnreachable, Err: fmt.Errorf("API responded with status %d", resp.StatusCode)}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package printix
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Ping(t *testing.T) {
+	tests := []struct {
+		name       string
+		authStatus int
+		apiStatus  int
+		wantKind   PingErrorKind
+	}{
+		{name: "healthy", authStatus: http.StatusOK, apiStatus: http.StatusOK},
+		{name: "bad credentials", authStatus: http.StatusUnauthorized, wantKind: PingAuthFailed},
+		{name: "token rejected", authStatus: http.StatusOK, apiStatus: http.StatusForbidden, wantKind: PingAuthFailed},
+		{name: "API unavailable", authStatus: http.StatusOK, apiStatus: http.StatusServiceUnavailable, wantKind: PingUnreachable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/oauth/token" {
+					w.WriteHeader(tt.authStatus)
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{
+						"access_token": "test-token",
+						"expires_in":   3600,
+					})
+					return
+				}
+				assert.Equal(t, "/cloudprint", r.URL.Path)
+				w.WriteHeader(tt.apiStatus)
+			}))
+			defer server.Close()
+
+			client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"))
+			err := client.Ping(context.Background())
+
+			if tt.wantKind == "" {
+				require.NoError(t, err)
+				return
+			}
+			var pingErr *PingError
+			require.True(t, errors.As(err, &pingErr))
+			assert.Equal(t, tt.wantKind, pingErr.Kind)
+		})
+	}
+
+	t.Run("unreachable", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		url := server.URL
+		server.Close()
+
+		client := New("test-id", "test-secret", WithBaseURL(url), WithAuthURL(url+"/oauth/token"))
+		err := client.Ping(context.Background())
+
+		var pingErr *PingError
+		require.True(t, errors.As(err, &pingErr))
+		assert.Equal(t, PingUnreachable, pingErr.Kind)
+	})
+}
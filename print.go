@@ -3,31 +3,147 @@ package printix
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 )
 
 // PrintJob represents a print job submission.
 type PrintJob struct {
-	PrinterID     string         `json:"-"` // Not sent in body, used in URL
-	Title         string         `json:"title,omitempty"`
-	User          string         `json:"user,omitempty"`
-	PDL           string         `json:"PDL,omitempty"`
+	PrinterID string `json:"-"` // Not sent in body, used in URL
+	Title     string `json:"title,omitempty"`
+	User      string `json:"user,omitempty"`
+	PDL       string `json:"PDL,omitempty"`
 	// v1.1 properties
 	Color           *bool  `json:"color,omitempty"`
-	Duplex          string `json:"duplex,omitempty"`      // NONE, SHORT_EDGE, LONG_EDGE
+	Duplex          string `json:"duplex,omitempty"`           // NONE, SHORT_EDGE, LONG_EDGE
 	PageOrientation string `json:"page_orientation,omitempty"` // PORTRAIT, LANDSCAPE, AUTO
 	Copies          *int   `json:"copies,omitempty"`
 	MediaSize       string `json:"media_size,omitempty"`
-	Scaling         string `json:"scaling,omitempty"`     // NOSCALE, SHRINK, FIT
-	TestMode        bool   `json:"-"`                     // Not sent to API
-	UseV11          bool   `json:"-"`                     // Use v1.1 API
+	Scaling         string `json:"scaling,omitempty"` // NOSCALE, SHRINK, FIT
+	TestMode        *bool  `json:"-"`                 // Overrides WithTestMode when set; not sent to API
+	UseV11          bool   `json:"-"`                 // Use v1.1 API
+	APIVersion      string `json:"-"`                 // APIVersion10, APIVersion11 or later; empty selects automatically
 }
 
+// Submit API versions accepted in PrintJob.APIVersion.
+const (
+	APIVersion10 = "1.0"
+	APIVersion11 = "1.1"
+)
+
+// Media sizes accepted in PrintJob.MediaSize.
+const (
+	MediaSizeA0     = "A0"
+	MediaSizeA1     = "A1"
+	MediaSizeA2     = "A2"
+	MediaSizeA3     = "A3"
+	MediaSizeA4     = "A4"
+	MediaSizeA5     = "A5"
+	MediaSizeB4     = "B4"
+	MediaSizeB5     = "B5"
+	MediaSizeLetter = "LETTER"
+	MediaSizeLegal  = "LEGAL"
+)
+
+// ValidateMediaSize checks if the media size is one of the known MediaSize constants.
+func ValidateMediaSize(s string) error {
+	switch s {
+	case MediaSizeA0, MediaSizeA1, MediaSizeA2, MediaSizeA3, MediaSizeA4, MediaSizeA5,
+		MediaSizeB4, MediaSizeB5, MediaSizeLetter, MediaSizeLegal:
+		return nil
+	}
+	return fmt.Errorf("unknown media size %q", s)
+}
+
+// Printer document languages accepted in PrintJob.PDL.
+const (
+	PDLPDF        = "PDF"
+	PDLPCL5       = "PCL5"
+	PDLPostScript = "POSTSCRIPT"
+	PDLXPS        = "XPS"
+	PDLZPL        = "ZPL"
+	PDLPWGRaster  = "PWG_RASTER"
+)
+
+// ValidatePDL checks if the PDL is one of the known PDL constants.
+func ValidatePDL(s string) error {
+	switch s {
+	case PDLPDF, PDLPCL5, PDLPostScript, PDLXPS, PDLZPL, PDLPWGRaster:
+		return nil
+	}
+	return fmt.Errorf("unknown PDL %q", s)
+}
+
+// Duplex modes accepted in PrintOptions.Duplex.
+const (
+	DuplexNone      = "none"
+	DuplexLongEdge  = "long-edge"
+	DuplexShortEdge = "short-edge"
+)
+
+// Page orientations accepted in PrintOptions.Orientation.
+const (
+	OrientationPortrait  = "portrait"
+	OrientationLandscape = "landscape"
+)
+
+// Scaling modes accepted in PrintOptions.Scaling.
+const (
+	ScalingNoScale = "noscale"
+	ScalingShrink  = "shrink"
+	ScalingFit     = "fit"
+)
+
+// duplexValues maps PrintOptions duplex modes to their v1.1 API values.
+var duplexValues = map[string]string{
+	DuplexNone:      "NONE",
+	DuplexLongEdge:  "LONG_EDGE",
+	DuplexShortEdge: "SHORT_EDGE",
+}
+
+// orientationValues maps PrintOptions orientations to their v1.1 API values.
+var orientationValues = map[string]string{
+	OrientationPortrait:  "PORTRAIT",
+	OrientationLandscape: "LANDSCAPE",
+}
+
+// scalingValues maps PrintOptions scaling modes to their v1.1 API values.
+var scalingValues = map[string]string{
+	ScalingNoScale: "NOSCALE",
+	ScalingShrink:  "SHRINK",
+	ScalingFit:     "FIT",
+}
+
+// ValidateDuplex checks if the duplex mode is one of the Duplex constants.
+func ValidateDuplex(s string) error {
+	if _, ok := duplexValues[s]; !ok {
+		return fmt.Errorf("unknown duplex mode %q", s)
+	}
+	return nil
+}
+
+// ValidateOrientation checks if the orientation is one of the Orientation constants.
+func ValidateOrientation(s string) error {
+	if _, ok := orientationValues[s]; !ok {
+		return fmt.Errorf("unknown orientation %q", s)
+	}
+	return nil
+}
+
+// ErrPrinterOffline is returned by Submit when WithPreSubmitConnectivityCheck
+// is enabled and the printer is not online.
+var ErrPrinterOffline = errors.New("printer is offline")
+
 // SubmitResponse represents the response from submitting a print job.
 type SubmitResponse struct {
 	Response
@@ -53,6 +169,8 @@ type SubmitResponse struct {
 			Href string `json:"href"`
 		} `json:"uploadCompleted"`
 	} `json:"_links"`
+	// Warnings lists adjustments the client made to the job, e.g. when falling back to v1.0.
+	Warnings []string `json:"-"`
 }
 
 // CompleteUploadRequest represents the request to complete an upload.
@@ -60,13 +178,71 @@ type CompleteUploadRequest struct {
 	JobID string `json:"jobId"`
 }
 
+// NormalizedJobStatus returns the status of the submitted job in canonical
+// form, see NormalizeJobStatus. Job.Status keeps the raw value.
+func (r *SubmitResponse) NormalizedJobStatus() JobStatus {
+	return NormalizeJobStatus(r.Job.Status)
+}
+
+// submittedJob returns the job details of the response as a Job.
+func (r *SubmitResponse) submittedJob(printerID string) *Job {
+	return &Job{
+		ID:               r.Job.ID,
+		PrinterID:        printerID,
+		Title:            r.Job.Title,
+		Status:           r.Job.Status,
+		NormalizedStatus: r.NormalizedJobStatus(),
+	}
+}
+
 // PrintOptions represents print job options.
 type PrintOptions struct {
 	Copies      int    `json:"copies,omitempty"`
-	Color       bool   `json:"color,omitempty"`
-	Duplex      string `json:"duplex,omitempty"` // "none", "long-edge", "short-edge"
+	Color       *bool  `json:"color,omitempty"`  // nil uses the printer default; see Bool
+	Duplex      string `json:"duplex,omitempty"` // DuplexNone, DuplexLongEdge, DuplexShortEdge
 	PageRange   string `json:"pageRange,omitempty"`
-	Orientation string `json:"orientation,omitempty"` // "portrait", "landscape"
+	Orientation string `json:"orientation,omitempty"` // OrientationPortrait, OrientationLandscape
+	Scaling     string `json:"scaling,omitempty"`     // ScalingNoScale, ScalingShrink, ScalingFit
+
+	// UploadProgress is called while the document is uploaded. See UploadDocumentWithProgress.
+	UploadProgress func(bytesSent, total int64) `json:"-"`
+	// ContentType overrides the Content-Type of the upload. When empty it is
+	// derived from the PDL, with application/pdf for PDF.
+	ContentType string `json:"-"`
+}
+
+// Bool returns a pointer to v, for optional settings such as PrintOptions.Color
+// or PrintJob.TestMode.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// applyTo maps the options onto the v1.1 properties of a print job.
+// A nil receiver leaves the job unchanged.
+func (o *PrintOptions) applyTo(job *PrintJob) {
+	if o == nil {
+		return
+	}
+
+	job.UseV11 = true
+	if o.Copies > 0 {
+		job.Copies = &o.Copies
+	}
+	if o.Color != nil {
+		color := *o.Color
+		job.Color = &color
+	}
+	// Map old duplex values to new format
+	if duplex, ok := duplexValues[o.Duplex]; ok {
+		job.Duplex = duplex
+	}
+	// Map old orientation to new format
+	if orientation, ok := orientationValues[o.Orientation]; ok {
+		job.PageOrientation = orientation
+	}
+	if scaling, ok := scalingValues[o.Scaling]; ok {
+		job.Scaling = scaling
+	}
 }
 
 // Submit creates a new print job.
@@ -75,8 +251,30 @@ func (c *Client) Submit(ctx context.Context, job *PrintJob) (*SubmitResponse, er
 		return nil, fmt.Errorf("tenant ID is required for job submission")
 	}
 
+	if c.strictValidation && job.MediaSize != "" {
+		if err := ValidateMediaSize(job.MediaSize); err != nil {
+			return nil, err
+		}
+	}
+
+	if job.PDL != "" && !c.allowAnyPDL {
+		if err := ValidatePDL(job.PDL); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.onlineCheck {
+		printer, err := c.GetPrinter(ctx, job.PrinterID)
+		if err != nil {
+			return nil, fmt.Errorf("checking printer connectivity: %w", err)
+		}
+		if !printer.IsOnline() {
+			return nil, fmt.Errorf("%w: printer %s is %q", ErrPrinterOffline, job.PrinterID, printer.ConnectionStatus)
+		}
+	}
+
 	endpoint := fmt.Sprintf(submitEndpoint, c.tenantID, job.PrinterID)
-	
+
 	// Add query parameters
 	params := url.Values{}
 	if job.Title != "" {
@@ -88,25 +286,39 @@ func (c *Client) Submit(ctx context.Context, job *PrintJob) (*SubmitResponse, er
 	if job.PDL != "" {
 		params.Set("PDL", job.PDL)
 	}
-	if c.testMode || job.TestMode {
+	testMode := c.testMode
+	if job.TestMode != nil {
+		testMode = *job.TestMode
+	}
+	if testMode {
 		params.Set("test", "true")
 	}
-	
+
 	if len(params) > 0 {
 		endpoint += "?" + params.Encode()
 	}
 
 	var requestBody any
+	var v11Body map[string]any
 	headers := make(map[string]string)
-	
+
 	// Use v1.1 if specified or if any v1.1 properties are set
-	if job.UseV11 || job.Color != nil || job.Duplex != "" || job.PageOrientation != "" || 
-	   job.Copies != nil || job.MediaSize != "" || job.Scaling != "" {
-		headers["version"] = "1.1"
+	hasV11Settings := job.Color != nil || job.Duplex != "" || job.PageOrientation != "" ||
+		job.Copies != nil || job.MediaSize != "" || job.Scaling != ""
+	version := job.APIVersion
+	if version == "" && (job.UseV11 || hasV11Settings) {
+		version = APIVersion11
+	}
+	if version == APIVersion10 && hasV11Settings {
+		return nil, fmt.Errorf("print settings require API version %s, but version %s was requested", APIVersion11, APIVersion10)
+	}
+
+	if version != "" && version != APIVersion10 {
+		headers["version"] = version
 		headers["Content-Type"] = "application/json"
-		
+
 		// Build v1.1 request body
-		v11Body := make(map[string]any)
+		v11Body = make(map[string]any)
 		if job.Color != nil {
 			v11Body["color"] = *job.Color
 		}
@@ -125,7 +337,7 @@ func (c *Client) Submit(ctx context.Context, job *PrintJob) (*SubmitResponse, er
 		if job.Scaling != "" {
 			v11Body["scaling"] = job.Scaling
 		}
-		
+
 		if len(v11Body) > 0 {
 			requestBody = v11Body
 		}
@@ -136,6 +348,23 @@ func (c *Client) Submit(ctx context.Context, job *PrintJob) (*SubmitResponse, er
 		return nil, fmt.Errorf("submitting job: %w", err)
 	}
 
+	// Older tenants reject the v1.1 submit; retry once as v1.0 if allowed
+	var warnings []string
+	if c.v11Fallback && job.APIVersion == "" && headers["version"] == APIVersion11 && versionRejected(resp) {
+		_ = resp.Body.Close()
+
+		warning := fmt.Sprintf("v1.1 submit rejected with status %d, submitted as v1.0", resp.StatusCode)
+		if len(v11Body) > 0 {
+			warning += " without " + strings.Join(slices.Sorted(maps.Keys(v11Body)), ", ")
+		}
+		warnings = append(warnings, warning)
+
+		resp, err = c.doRequest(ctx, http.MethodPost, endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("submitting job as v1.0: %w", err)
+		}
+	}
+
 	var submitResp SubmitResponse
 	if err := parseResponse(resp, &submitResp); err != nil {
 		return nil, fmt.Errorf("parsing submit response: %w", err)
@@ -144,30 +373,89 @@ func (c *Client) Submit(ctx context.Context, job *PrintJob) (*SubmitResponse, er
 	if !submitResp.Success {
 		return nil, fmt.Errorf("submit failed: %s (error ID: %s)", submitResp.ErrorDescription, submitResp.ErrorID)
 	}
+	submitResp.Warnings = warnings
 
 	return &submitResp, nil
 }
 
-// UploadDocument uploads a document to the cloud storage.
-func (c *Client) UploadDocument(ctx context.Context, uploadLink string, headers map[string]string, data []byte) error {
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadLink, bytes.NewReader(data))
+// versionRejected reports whether a submit response rejects the API version:
+// a 415, or a 400 whose body names the version or content type. The response
+// body is restored so the error can still be parsed.
+func versionRejected(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusUnsupportedMediaType:
+		return true
+	case http.StatusBadRequest:
+	default:
+		return false
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
 	if err != nil {
-		return fmt.Errorf("creating upload request: %w", err)
+		return false
 	}
 
-	// Set content type
-	req.Header.Set("Content-Type", "application/pdf")
+	message := strings.ToLower(string(body))
+	return strings.Contains(message, "version") || strings.Contains(message, "content type") ||
+		strings.Contains(message, "content-type")
+}
 
-	// Add any additional headers provided by Printix
-	for k, v := range headers {
-		req.Header.Set(k, v)
+// UploadDocument uploads a document to the cloud storage. The upload is sent
+// as application/pdf unless headers set a Content-Type.
+func (c *Client) UploadDocument(ctx context.Context, uploadLink string, headers map[string]string, data []byte) error {
+	return c.UploadDocumentWithProgress(ctx, uploadLink, headers, data, nil)
+}
+
+// UploadDocumentWithProgress uploads a document to the cloud storage and
+// reports progress to the callback. The callback is invoked from the goroutine
+// sending the request body as data is read, and a final time with
+// bytesSent == total once the upload has succeeded. A nil callback disables
+// progress reporting.
+func (c *Client) UploadDocumentWithProgress(ctx context.Context, uploadLink string, headers map[string]string, data []byte, progress func(bytesSent, total int64)) error {
+	if c.dryRun {
+		c.logDryRun(ctx, http.MethodPut, uploadLink)
+		return nil
 	}
 
+	total := int64(len(data))
+
 	// Use a separate HTTP client for cloud storage (no auth needed)
-	storageClient := &http.Client{Timeout: 60 * time.Second}
-	resp, err := storageClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("uploading document: %w", err)
+	storageClient := c.storageClient()
+
+	var resp *http.Response
+	for attempt := 0; ; attempt++ {
+		var body io.Reader = bytes.NewReader(data)
+		if progress != nil {
+			body = &progressReader{r: body, total: total, fn: progress}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadLink, body)
+		if err != nil {
+			return fmt.Errorf("creating upload request: %w", err)
+		}
+		req.ContentLength = total
+
+		// Set content type
+		req.Header.Set("Content-Type", "application/pdf")
+		req.Header.Set("User-Agent", c.userAgent)
+
+		// Add any additional headers provided by Printix
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+
+		resp, err = storageClient.Do(req)
+		if err == nil {
+			break
+		}
+		if attempt >= c.maxRetries || !c.canRetry(ctx, http.MethodPut, err) {
+			return fmt.Errorf("uploading document: %w", err)
+		}
+		if err := sleepBackoff(ctx, attempt); err != nil {
+			return fmt.Errorf("uploading document: %w", err)
+		}
 	}
 	defer func() {
 		_ = resp.Body.Close()
@@ -181,9 +469,30 @@ func (c *Client) UploadDocument(ctx context.Context, uploadLink string, headers
 		return fmt.Errorf("upload failed with status %d: %s", resp.StatusCode, string(body))
 	}
 
+	if progress != nil {
+		progress(total, total)
+	}
+
 	return nil
 }
 
+// progressReader wraps a reader and reports the number of bytes read.
+type progressReader struct {
+	r     io.Reader
+	sent  int64
+	total int64
+	fn    func(bytesSent, total int64)
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.sent += int64(n)
+		p.fn(p.sent, p.total)
+	}
+	return n, err
+}
+
 // CompleteUpload notifies Printix that the document upload is complete.
 func (c *Client) CompleteUpload(ctx context.Context, completeURL string) error {
 	// CompleteUpload uses the HAL link provided in the submit response
@@ -205,146 +514,251 @@ func (c *Client) CompleteUpload(ctx context.Context, completeURL string) error {
 }
 
 // PrintFile prints a file using Printix.
+// If ctx is cancelled after the job was submitted but before the upload
+// completed, the job is deleted so it does not remain stuck in "Created".
 func (c *Client) PrintFile(ctx context.Context, printerID, title, filePath string, options *PrintOptions) error {
+	_, err := c.PrintFileJob(ctx, printerID, title, filePath, options)
+	return err
+}
+
+// PrintFileByName prints a file on the printer with the given name. It fails
+// with ErrAmbiguousPrinterName if several printers share the name.
+func (c *Client) PrintFileByName(ctx context.Context, printerName, title, filePath string, options *PrintOptions) error {
+	printerID, err := c.resolvePrinterName(ctx, printerName)
+	if err != nil {
+		return fmt.Errorf("resolving printer: %w", err)
+	}
+
+	return c.PrintFile(ctx, printerID, title, filePath, options)
+}
+
+// PrintFileJob prints a file like PrintFile and returns the created job so it
+// can be tracked, e.g. with WatchJob.
+func (c *Client) PrintFileJob(ctx context.Context, printerID, title, filePath string, options *PrintOptions) (*Job, error) {
 	// Read the file
 	data, err := os.ReadFile(filePath)
 	if err != nil {
-		return fmt.Errorf("reading file: %w", err)
+		return nil, fmt.Errorf("reading file: %w", err)
 	}
 
 	// Determine PDL based on file extension
 	var pdl string
-	if len(filePath) > 4 {
-		switch filePath[len(filePath)-4:] {
-		case ".zpl":
-			pdl = "ZPL"
-		case ".pcl":
-			pdl = "PCL5"
-		case ".ps":
-			pdl = "POSTSCRIPT"
-		case ".xps":
-			pdl = "XPS"
-		}
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".zpl":
+		pdl = PDLZPL
+	case ".pcl":
+		pdl = PDLPCL5
+	case ".ps":
+		pdl = PDLPostScript
+	case ".xps":
+		pdl = PDLXPS
+	case ".pwg":
+		pdl = PDLPWGRaster
+	}
+
+	submitResp, err := c.printData(ctx, printerID, title, data, pdl, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return submitResp.submittedJob(printerID), nil
+}
+
+// PrintData prints raw data using Printix. Cancelled uploads are cleaned up
+// as described for PrintFile.
+func (c *Client) PrintData(ctx context.Context, printerID, title string, data []byte, pdl string, options *PrintOptions) error {
+	_, err := c.PrintDataJob(ctx, printerID, title, data, pdl, options)
+	return err
+}
+
+// PrintDataJob prints raw data like PrintData and returns the full submit
+// response, including the job details and its HAL links.
+func (c *Client) PrintDataJob(ctx context.Context, printerID, title string, data []byte, pdl string, options *PrintOptions) (*SubmitResponse, error) {
+	return c.printData(ctx, printerID, title, data, pdl, options)
+}
+
+// zplContentType is the MIME type of ZPL label data.
+const zplContentType = "application/vnd.zebra-zpl"
+
+// pdlUploadTypes maps a PDL to the Content-Type of its upload. PDF uploads
+// use the UploadDocument default.
+var pdlUploadTypes = map[string]string{
+	PDLPCL5:       "application/vnd.hp-pcl",
+	PDLPostScript: "application/postscript",
+	PDLXPS:        "application/oxps",
+	PDLZPL:        zplContentType,
+	PDLPWGRaster:  "image/pwg-raster",
+}
+
+// PrintZPL prints ZPL label data. The data must hold complete labels, starting
+// with ^XA and ending with ^XZ. Label dimensions are part of the ZPL itself
+// (^PW, ^LL); options apply as for PrintData.
+func (c *Client) PrintZPL(ctx context.Context, printerID, title string, zpl []byte, options *PrintOptions) error {
+	trimmed := bytes.TrimSpace(zpl)
+	if !bytes.HasPrefix(trimmed, []byte("^XA")) || !bytes.HasSuffix(trimmed, []byte("^XZ")) {
+		return fmt.Errorf("invalid ZPL: data must start with ^XA and end with ^XZ")
 	}
 
+	_, err := c.printData(ctx, printerID, title, zpl, PDLZPL, options)
+	return err
+}
+
+// printData submits, uploads and completes a print job for raw data.
+func (c *Client) printData(ctx context.Context, printerID, title string, data []byte, pdl string, options *PrintOptions) (*SubmitResponse, error) {
 	// Create print job
 	job := &PrintJob{
 		PrinterID: printerID,
 		Title:     title,
 		User:      "MTS API",
 		PDL:       pdl,
-		TestMode:  c.testMode,
 	}
 
-	// Add options if provided  
+	// Add options if provided
+	options.applyTo(job)
+
+	var progress func(bytesSent, total int64)
+	contentType := pdlUploadTypes[pdl]
 	if options != nil {
-		job.UseV11 = true
-		if options.Copies > 0 {
-			job.Copies = &options.Copies
-		}
-		if options.Color {
-			job.Color = &options.Color
-		}
-		// Map old duplex values to new format
-		switch options.Duplex {
-		case "none":
-			job.Duplex = "NONE"
-		case "long-edge":
-			job.Duplex = "LONG_EDGE"
-		case "short-edge":
-			job.Duplex = "SHORT_EDGE"
-		}
-		// Map old orientation to new format
-		switch options.Orientation {
-		case "portrait":
-			job.PageOrientation = "PORTRAIT"
-		case "landscape":
-			job.PageOrientation = "LANDSCAPE"
+		progress = options.UploadProgress
+		if options.ContentType != "" {
+			contentType = options.ContentType
 		}
 	}
 
+	return c.submitJob(ctx, job, data, contentType, progress)
+}
+
+// submitJob submits job, uploads data to the first upload link and completes the upload.
+// A non-empty contentType replaces the default upload content type unless the
+// upload link prescribes one.
+func (c *Client) submitJob(ctx context.Context, job *PrintJob, data []byte, contentType string, progress func(bytesSent, total int64)) (*SubmitResponse, error) {
 	// Submit the job
 	submitResp, err := c.Submit(ctx, job)
 	if err != nil {
-		return fmt.Errorf("submitting print job: %w", err)
+		return nil, fmt.Errorf("submitting print job: %w", err)
+	}
+	if c.dryRun {
+		return submitResp, nil
 	}
 
 	// Upload the document
 	if len(submitResp.UploadLinks) == 0 {
-		return fmt.Errorf("no upload links provided")
+		return nil, fmt.Errorf("no upload links provided")
 	}
 
 	uploadLink := submitResp.UploadLinks[0]
-	if err := c.UploadDocument(ctx, uploadLink.URL, uploadLink.Headers, data); err != nil {
-		return fmt.Errorf("uploading document: %w", err)
+	headers := uploadLink.Headers
+	if contentType != "" {
+		headers = map[string]string{"Content-Type": contentType}
+		for k, v := range uploadLink.Headers {
+			headers[k] = v
+		}
+	}
+	if err := c.UploadDocumentWithProgress(ctx, uploadLink.URL, headers, data, progress); err != nil {
+		c.abandonJob(ctx, submitResp.Job.ID)
+		return nil, fmt.Errorf("uploading document: %w", err)
 	}
 
 	// Complete the upload using the HAL link
 	if err := c.CompleteUpload(ctx, submitResp.Links.UploadCompleted.Href); err != nil {
-		return fmt.Errorf("completing upload: %w", err)
+		c.abandonJob(ctx, submitResp.Job.ID)
+		return nil, fmt.Errorf("completing upload: %w", err)
 	}
 
-	return nil
+	return submitResp, nil
 }
 
-// PrintData prints raw data using Printix.
-func (c *Client) PrintData(ctx context.Context, printerID, title string, data []byte, pdl string, options *PrintOptions) error {
-	// Create print job
-	job := &PrintJob{
-		PrinterID: printerID,
-		Title:     title,
-		User:      "MTS API",
-		PDL:       pdl,
-		TestMode:  c.testMode,
-	}
+// abandonJobTimeout bounds the cleanup of a job whose upload was cancelled.
+const abandonJobTimeout = 10 * time.Second
 
-	// Add options if provided  
-	if options != nil {
-		job.UseV11 = true
-		if options.Copies > 0 {
-			job.Copies = &options.Copies
-		}
-		if options.Color {
-			job.Color = &options.Color
-		}
-		// Map old duplex values to new format
-		switch options.Duplex {
-		case "none":
-			job.Duplex = "NONE"
-		case "long-edge":
-			job.Duplex = "LONG_EDGE"
-		case "short-edge":
-			job.Duplex = "SHORT_EDGE"
-		}
-		// Map old orientation to new format
-		switch options.Orientation {
-		case "portrait":
-			job.PageOrientation = "PORTRAIT"
-		case "landscape":
-			job.PageOrientation = "LANDSCAPE"
-		}
+// abandonJob deletes a submitted job when ctx was cancelled before its upload
+// completed, so no job is left waiting for a document that never arrives.
+// Errors are only logged, the caller reports the cancellation.
+func (c *Client) abandonJob(ctx context.Context, jobID string) {
+	if ctx.Err() == nil || jobID == "" {
+		return
 	}
 
-	// Submit the job
-	submitResp, err := c.Submit(ctx, job)
-	if err != nil {
-		return fmt.Errorf("submitting print job: %w", err)
+	cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), abandonJobTimeout)
+	defer cancel()
+
+	if err := c.DeleteJob(cleanupCtx, jobID); err != nil {
+		c.logDebug(ctx, "printix abandoned job cleanup failed", "job_id", jobID, "error", err)
 	}
+}
 
-	// Upload the document
-	if len(submitResp.UploadLinks) == 0 {
-		return fmt.Errorf("no upload links provided")
+// broadcastConcurrency limits the number of concurrent submissions in Broadcast.
+const broadcastConcurrency = 4
+
+// BroadcastResult holds the outcome of printing to a single printer in Broadcast.
+type BroadcastResult struct {
+	PrinterID string
+	JobID     string
+	Err       error
+}
+
+// Broadcast prints the same document to several printers concurrently. A
+// failure for one printer does not abort the others; the results are aligned
+// with printerIDs and the returned error combines all individual failures.
+func (c *Client) Broadcast(ctx context.Context, printerIDs []string, title string, data []byte, pdl string, options *PrintOptions) ([]BroadcastResult, error) {
+	results := make([]BroadcastResult, len(printerIDs))
+	sem := make(chan struct{}, broadcastConcurrency)
+
+	var wg sync.WaitGroup
+	for i, printerID := range printerIDs {
+		results[i].PrinterID = printerID
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				results[i].Err = ctx.Err()
+				return
+			}
+
+			submitResp, err := c.printData(ctx, printerID, title, data, pdl, options)
+			if err != nil {
+				results[i].Err = err
+				return
+			}
+			results[i].JobID = submitResp.Job.ID
+		}()
 	}
+	wg.Wait()
 
-	uploadLink := submitResp.UploadLinks[0]
-	if err := c.UploadDocument(ctx, uploadLink.URL, uploadLink.Headers, data); err != nil {
-		return fmt.Errorf("uploading document: %w", err)
+	var errs []error
+	for _, result := range results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("printer %s: %w", result.PrinterID, result.Err))
+		}
 	}
 
-	// Complete the upload using the HAL link
-	if err := c.CompleteUpload(ctx, submitResp.Links.UploadCompleted.Href); err != nil {
-		return fmt.Errorf("completing upload: %w", err)
+	return results, errors.Join(errs...)
+}
+
+// PrintTarget identifies a printer to submit to in PrintMultiple.
+type PrintTarget struct {
+	PrinterID string
+}
+
+// PrintMultiple prints the same data to several printers concurrently. It
+// returns one error per target, aligned with targets; a failing target does
+// not stop the others.
+func (c *Client) PrintMultiple(ctx context.Context, targets []PrintTarget, title string, data []byte, pdl string, options *PrintOptions) []error {
+	printerIDs := make([]string, len(targets))
+	for i, target := range targets {
+		printerIDs[i] = target.PrinterID
 	}
 
-	return nil
+	results, _ := c.Broadcast(ctx, printerIDs, title, data, pdl, options)
+
+	errs := make([]error, len(results))
+	for i, result := range results {
+		errs[i] = result.Err
+	}
+	return errs
 }
@@ -1,11 +1,19 @@
 package printix
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -117,7 +125,7 @@ func TestClient_Submit(t *testing.T) {
 			job: &PrintJob{
 				PrinterID: "printer-123",
 				Title:     "Test Document",
-				TestMode:  true,
+				TestMode:  Bool(true),
 			},
 			setupServer: func() *httptest.Server {
 				var server *httptest.Server
@@ -326,3 +334,861 @@ func TestClient_CompleteUpload(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_UploadDocumentWithProgress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Len(t, body, 64*1024)
+		assert.Equal(t, int64(64*1024), r.ContentLength)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	var calls [][2]int64
+	progress := func(bytesSent, total int64) {
+		calls = append(calls, [2]int64{bytesSent, total})
+	}
+
+	client := New("test-id", "test-secret")
+	data := bytes.Repeat([]byte("x"), 64*1024)
+	err := client.UploadDocumentWithProgress(context.Background(), server.URL+"/upload", nil, data, progress)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, calls)
+	var last int64
+	for _, call := range calls {
+		assert.GreaterOrEqual(t, call[0], last)
+		assert.Equal(t, int64(len(data)), call[1])
+		last = call[0]
+	}
+	assert.Equal(t, [2]int64{int64(len(data)), int64(len(data))}, calls[len(calls)-1])
+}
+
+func TestClient_Submit_V11Fallback(t *testing.T) {
+	var attempts int
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/printers/printer-123/jobs":
+			attempts++
+			if r.Header.Get("version") == "1.1" {
+				w.WriteHeader(http.StatusUnsupportedMediaType)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Empty(t, body)
+
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+				"job":     map[string]interface{}{"id": "job-456"},
+				"_links": map[string]interface{}{
+					"uploadCompleted": map[string]interface{}{"href": server.URL + "/complete"},
+				},
+			})
+		}
+	}))
+	defer server.Close()
+
+	copies := 2
+	job := &PrintJob{PrinterID: "printer-123", Title: "Test", Copies: &copies, Duplex: "LONG_EDGE"}
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+	_, err := client.Submit(context.Background(), job)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 415")
+	assert.Equal(t, 1, attempts)
+
+	attempts = 0
+	client = New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"), WithV11Fallback())
+	got, err := client.Submit(context.Background(), job)
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, "job-456", got.Job.ID)
+	require.Len(t, got.Warnings, 1)
+	assert.Contains(t, got.Warnings[0], "copies, duplex")
+}
+
+func TestClient_Submit_V11FallbackValidationError(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/printers/printer-123/jobs":
+			attempts++
+			if r.Header.Get("version") == "1.1" {
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"success":          false,
+					"errorDescription": "copies must be between 1 and 99",
+				})
+				return
+			}
+			t.Error("validation error must not fall back to v1.0")
+		}
+	}))
+	defer server.Close()
+
+	copies := 500
+	job := &PrintJob{PrinterID: "printer-123", Title: "Test", Copies: &copies, Color: Bool(false)}
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"), WithV11Fallback())
+	_, err := client.Submit(context.Background(), job)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 400")
+	assert.Contains(t, err.Error(), "copies must be between 1 and 99")
+	assert.Equal(t, 1, attempts)
+}
+
+func TestClient_Submit_V11FallbackVersionError(t *testing.T) {
+	var versions []string
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/printers/printer-123/jobs":
+			versions = append(versions, r.Header.Get("version"))
+			if r.Header.Get("version") == "1.1" {
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"success":          false,
+					"errorDescription": "Unsupported API version 1.1",
+				})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+				"job":     map[string]interface{}{"id": "job-456"},
+				"_links": map[string]interface{}{
+					"uploadCompleted": map[string]interface{}{"href": server.URL + "/complete"},
+				},
+			})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"), WithV11Fallback())
+	got, err := client.Submit(context.Background(), &PrintJob{PrinterID: "printer-123", Title: "Test", Duplex: "LONG_EDGE"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.1", ""}, versions)
+	assert.Len(t, got.Warnings, 1)
+}
+
+func TestValidateMediaSize(t *testing.T) {
+	for _, size := range []string{MediaSizeA0, MediaSizeA4, MediaSizeB5, MediaSizeLetter, MediaSizeLegal} {
+		assert.NoError(t, ValidateMediaSize(size), size)
+	}
+	for _, size := range []string{"LETER", "a4", ""} {
+		assert.Error(t, ValidateMediaSize(size), size)
+	}
+}
+
+func TestClient_Submit_StrictValidation(t *testing.T) {
+	client := New("test-id", "test-secret", WithTenantID("test-tenant"), WithStrictValidation())
+
+	_, err := client.Submit(context.Background(), &PrintJob{PrinterID: "printer-123", MediaSize: "LETER"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown media size "LETER"`)
+}
+
+func TestClient_Submit_ConnectivityCheck(t *testing.T) {
+	var submitted []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/printers/online":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "id": "online", "connectionStatus": "ONLINE"})
+		case "/cloudprint/tenants/test-tenant/printers/offline":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "id": "offline", "connectionStatus": "OFFLINE"})
+		default:
+			submitted = append(submitted, r.URL.Path)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "job": map[string]interface{}{"id": "job-1"}})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"),
+		WithTenantID("test-tenant"), WithPreSubmitConnectivityCheck())
+
+	_, err := client.Submit(context.Background(), &PrintJob{PrinterID: "offline", Title: "Doc"})
+	require.ErrorIs(t, err, ErrPrinterOffline)
+
+	_, err = client.Submit(context.Background(), &PrintJob{PrinterID: "online", Title: "Doc"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"/cloudprint/tenants/test-tenant/printers/online/jobs"}, submitted)
+}
+
+func TestClient_Submit_PDLValidation(t *testing.T) {
+	for _, pdl := range []string{PDLPDF, PDLPCL5, PDLPostScript, PDLXPS, PDLZPL, PDLPWGRaster} {
+		assert.NoError(t, ValidatePDL(pdl), pdl)
+	}
+
+	client := New("test-id", "test-secret", WithTenantID("test-tenant"))
+	_, err := client.Submit(context.Background(), &PrintJob{PrinterID: "printer-123", PDL: "PDF5"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown PDL "PDF5"`)
+
+	var gotPDL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+		gotPDL = r.URL.Query().Get("PDL")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+	}))
+	defer server.Close()
+
+	client = New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"),
+		WithTenantID("test-tenant"), WithoutPDLValidation())
+	_, err = client.Submit(context.Background(), &PrintJob{PrinterID: "printer-123", PDL: "PCL6"})
+	require.NoError(t, err)
+	assert.Equal(t, "PCL6", gotPDL)
+}
+
+func TestClient_Submit_TestModeOverride(t *testing.T) {
+	var gotTest []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+		gotTest = append(gotTest, r.URL.Query().Get("test"))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithTestMode(), WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"),
+		WithTenantID("test-tenant"))
+
+	for _, testMode := range []*bool{nil, Bool(false), Bool(true)} {
+		_, err := client.Submit(context.Background(), &PrintJob{PrinterID: "printer-123", TestMode: testMode})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"true", "", "true"}, gotTest)
+}
+
+func TestValidateDuplexAndOrientation(t *testing.T) {
+	for _, duplex := range []string{DuplexNone, DuplexLongEdge, DuplexShortEdge} {
+		assert.NoError(t, ValidateDuplex(duplex), duplex)
+	}
+	assert.Error(t, ValidateDuplex("LONG_EDGE"))
+	assert.Error(t, ValidateDuplex("long"))
+
+	for _, orientation := range []string{OrientationPortrait, OrientationLandscape} {
+		assert.NoError(t, ValidateOrientation(orientation), orientation)
+	}
+	assert.Error(t, ValidateOrientation("auto"))
+}
+
+func TestClient_Broadcast(t *testing.T) {
+	var mu sync.Mutex
+	uploads := map[string]string{}
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case strings.HasPrefix(r.URL.Path, "/cloudprint/tenants/test-tenant/printers/"):
+			printerID := strings.Split(r.URL.Path, "/")[5]
+			if printerID == "printer-2" {
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"success":          false,
+					"errorDescription": "Printer not found",
+					"errorId":          "ERR001",
+				})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":     true,
+				"job":         map[string]interface{}{"id": "job-" + printerID},
+				"uploadLinks": []map[string]interface{}{{"url": server.URL + "/upload/" + printerID}},
+				"_links": map[string]interface{}{
+					"uploadCompleted": map[string]interface{}{"href": server.URL + "/complete"},
+				},
+			})
+		case strings.HasPrefix(r.URL.Path, "/upload/"):
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			uploads[r.URL.Path] = string(body)
+			mu.Unlock()
+			w.WriteHeader(http.StatusCreated)
+		case r.URL.Path == "/complete":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+	results, err := client.Broadcast(context.Background(), []string{"printer-1", "printer-2", "printer-3"}, "Notice", []byte("%PDF-notice"), "", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "printer printer-2")
+	require.Len(t, results, 3)
+
+	assert.Equal(t, "job-printer-1", results[0].JobID)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, "printer-2", results[1].PrinterID)
+	assert.Error(t, results[1].Err)
+	assert.Equal(t, "job-printer-3", results[2].JobID)
+	assert.NoError(t, results[2].Err)
+
+	assert.Equal(t, map[string]string{
+		"/upload/printer-1": "%PDF-notice",
+		"/upload/printer-3": "%PDF-notice",
+	}, uploads)
+}
+
+func TestClient_PrintMultiple(t *testing.T) {
+	var mu sync.Mutex
+	uploads := map[string]string{}
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case strings.HasPrefix(r.URL.Path, "/cloudprint/tenants/test-tenant/printers/"):
+			printerID := strings.Split(r.URL.Path, "/")[5]
+			switch printerID {
+			case "missing":
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"success":          false,
+					"errorDescription": "Printer not found",
+					"errorId":          "ERR001",
+				})
+				return
+			case "slow":
+				// Finishes last, but its error slot must stay first
+				time.Sleep(50 * time.Millisecond)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":     true,
+				"job":         map[string]interface{}{"id": "job-" + printerID},
+				"uploadLinks": []map[string]interface{}{{"url": server.URL + "/upload/" + printerID}},
+				"_links": map[string]interface{}{
+					"uploadCompleted": map[string]interface{}{"href": server.URL + "/complete"},
+				},
+			})
+		case strings.HasPrefix(r.URL.Path, "/upload/"):
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			uploads[r.URL.Path] = string(body)
+			mu.Unlock()
+			w.WriteHeader(http.StatusCreated)
+		case r.URL.Path == "/complete":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	targets := []PrintTarget{{PrinterID: "slow"}, {PrinterID: "missing"}, {PrinterID: "fast"}}
+	errs := client.PrintMultiple(context.Background(), targets, "Receipt", []byte("%PDF-receipt"), "", nil)
+	require.Len(t, errs, 3)
+	assert.NoError(t, errs[0])
+	require.Error(t, errs[1])
+	assert.Contains(t, errs[1].Error(), "Printer not found")
+	assert.NoError(t, errs[2])
+
+	// The failing target does not stop the others
+	assert.Equal(t, map[string]string{
+		"/upload/slow": "%PDF-receipt",
+		"/upload/fast": "%PDF-receipt",
+	}, uploads)
+}
+
+func TestPrintOptions_applyTo(t *testing.T) {
+	duplexes := map[string]string{"": "", DuplexNone: "NONE", DuplexLongEdge: "LONG_EDGE", DuplexShortEdge: "SHORT_EDGE", "invalid": ""}
+	orientations := map[string]string{"": "", OrientationPortrait: "PORTRAIT", OrientationLandscape: "LANDSCAPE", "invalid": ""}
+	scalings := map[string]string{"": "", ScalingNoScale: "NOSCALE", ScalingShrink: "SHRINK", ScalingFit: "FIT", "invalid": ""}
+
+	for duplex, wantDuplex := range duplexes {
+		for orientation, wantOrientation := range orientations {
+			for scaling, wantScaling := range scalings {
+				name := fmt.Sprintf("duplex=%q orientation=%q scaling=%q", duplex, orientation, scaling)
+				t.Run(name, func(t *testing.T) {
+					job := &PrintJob{}
+					options := &PrintOptions{Duplex: duplex, Orientation: orientation, Scaling: scaling}
+					options.applyTo(job)
+
+					assert.True(t, job.UseV11)
+					assert.Equal(t, wantDuplex, job.Duplex)
+					assert.Equal(t, wantOrientation, job.PageOrientation)
+					assert.Equal(t, wantScaling, job.Scaling)
+					assert.Nil(t, job.Copies)
+					assert.Nil(t, job.Color)
+				})
+			}
+		}
+	}
+
+	t.Run("copies and color", func(t *testing.T) {
+		job := &PrintJob{}
+		(&PrintOptions{Copies: 3, Color: Bool(true)}).applyTo(job)
+
+		require.NotNil(t, job.Copies)
+		assert.Equal(t, 3, *job.Copies)
+		require.NotNil(t, job.Color)
+		assert.True(t, *job.Color)
+	})
+
+	t.Run("explicit monochrome", func(t *testing.T) {
+		job := &PrintJob{}
+		(&PrintOptions{Color: Bool(false)}).applyTo(job)
+
+		require.NotNil(t, job.Color)
+		assert.False(t, *job.Color)
+	})
+
+	t.Run("nil options", func(t *testing.T) {
+		job := &PrintJob{}
+		var options *PrintOptions
+		options.applyTo(job)
+
+		assert.Equal(t, &PrintJob{}, job)
+	})
+}
+
+func TestClient_PrintData_ColorUnset(t *testing.T) {
+	var bodies []map[string]any
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/printers/printer-1/jobs":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			bodies = append(bodies, body)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":     true,
+				"job":         map[string]interface{}{"id": "job-1"},
+				"uploadLinks": []map[string]interface{}{{"url": server.URL + "/upload"}},
+				"_links": map[string]interface{}{
+					"uploadCompleted": map[string]interface{}{"href": server.URL + "/complete"},
+				},
+			})
+		case "/upload":
+			w.WriteHeader(http.StatusCreated)
+		case "/complete":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	submitResp, err := client.PrintDataJob(context.Background(), "printer-1", "Doc", []byte("%PDF"), "", &PrintOptions{Copies: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "job-1", submitResp.Job.ID)
+	assert.Equal(t, server.URL+"/complete", submitResp.Links.UploadCompleted.Href)
+	err = client.PrintData(context.Background(), "printer-1", "Doc", []byte("%PDF"), "", &PrintOptions{Color: Bool(false)})
+	require.NoError(t, err)
+
+	require.Len(t, bodies, 2)
+	assert.Equal(t, map[string]any{"copies": float64(2)}, bodies[0])
+	assert.Equal(t, map[string]any{"color": false}, bodies[1])
+}
+
+func TestClient_PrintData_CancelledUpload(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deleted := make(chan string, 1)
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case r.URL.Path == "/cloudprint/tenants/test-tenant/printers/printer-1/jobs":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":     true,
+				"job":         map[string]interface{}{"id": "job-1", "status": "Created"},
+				"uploadLinks": []map[string]interface{}{{"url": server.URL + "/upload"}},
+			})
+		case r.URL.Path == "/upload":
+			// The caller gives up while the document is being uploaded.
+			_, _ = io.ReadAll(r.Body)
+			cancel()
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		case r.URL.Path == "/cloudprint/tenants/test-tenant/jobs/job-1" && r.Method == http.MethodDelete:
+			deleted <- "job-1"
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	err := client.PrintData(ctx, "printer-1", "Doc", []byte("%PDF"), "", nil)
+	require.ErrorIs(t, err, context.Canceled)
+
+	select {
+	case id := <-deleted:
+		assert.Equal(t, "job-1", id)
+	default:
+		t.Fatal("job was not deleted after the upload was cancelled")
+	}
+}
+
+func TestClient_PrintFileJob(t *testing.T) {
+	var completed bool
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/printers/printer-1/jobs":
+			assert.Equal(t, PDLPCL5, r.URL.Query().Get("PDL"))
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":     true,
+				"job":         map[string]interface{}{"id": "job-1", "title": "Report", "status": "Created"},
+				"uploadLinks": []map[string]interface{}{{"url": server.URL + "/upload"}},
+				"_links": map[string]interface{}{
+					"uploadCompleted": map[string]interface{}{"href": server.URL + "/complete"},
+				},
+			})
+		case "/upload":
+			w.WriteHeader(http.StatusCreated)
+		case "/complete":
+			completed = true
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "report.pcl")
+	require.NoError(t, os.WriteFile(path, []byte("PCL"), 0o600))
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	job, err := client.PrintFileJob(context.Background(), "printer-1", "Report", path, nil)
+	require.NoError(t, err)
+	assert.True(t, completed)
+	assert.Equal(t, &Job{
+		ID:               "job-1",
+		PrinterID:        "printer-1",
+		Title:            "Report",
+		Status:           "Created",
+		NormalizedStatus: JobStatusCreated,
+	}, job)
+
+	_, err = client.PrintFileJob(context.Background(), "printer-1", "Missing", filepath.Join(t.TempDir(), "missing.pdf"), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "reading file")
+}
+
+func TestClient_PrintZPL(t *testing.T) {
+	var gotPDL, gotContentType string
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/printers/printer-1/jobs":
+			gotPDL = r.URL.Query().Get("PDL")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":     true,
+				"job":         map[string]interface{}{"id": "job-1"},
+				"uploadLinks": []map[string]interface{}{{"url": server.URL + "/upload", "headers": map[string]string{"x-ms-blob-type": "BlockBlob"}}},
+				"_links": map[string]interface{}{
+					"uploadCompleted": map[string]interface{}{"href": server.URL + "/complete"},
+				},
+			})
+		case "/upload":
+			gotContentType = r.Header.Get("Content-Type")
+			w.WriteHeader(http.StatusCreated)
+		case "/complete":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	err := client.PrintZPL(context.Background(), "printer-1", "Label", []byte("^XA^PW812^LL1218^FO50,50^FDHello^FS^XZ\n"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, PDLZPL, gotPDL)
+	assert.Equal(t, "application/vnd.zebra-zpl", gotContentType)
+
+	err = client.PrintZPL(context.Background(), "printer-1", "Label", []byte("^FDHello^FS"), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid ZPL")
+}
+
+func TestClient_PrintData_ContentType(t *testing.T) {
+	var gotContentType string
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/printers/printer-1/jobs":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":     true,
+				"job":         map[string]interface{}{"id": "job-1"},
+				"uploadLinks": []map[string]interface{}{{"url": server.URL + "/upload"}},
+				"_links": map[string]interface{}{
+					"uploadCompleted": map[string]interface{}{"href": server.URL + "/complete"},
+				},
+			})
+		case "/upload":
+			gotContentType = r.Header.Get("Content-Type")
+			w.WriteHeader(http.StatusCreated)
+		case "/complete":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	tests := []struct {
+		name     string
+		pdl      string
+		options  *PrintOptions
+		expected string
+	}{
+		{"PDF default", "", nil, "application/pdf"},
+		{"derived from PDL", PDLPostScript, nil, "application/postscript"},
+		{"XPS", PDLXPS, nil, "application/oxps"},
+		{"PWG raster", PDLPWGRaster, nil, "image/pwg-raster"},
+		{"explicit override", PDLPCL5, &PrintOptions{ContentType: "application/octet-stream"}, "application/octet-stream"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.PrintData(context.Background(), "printer-1", "Doc", []byte("data"), tt.pdl, tt.options)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, gotContentType)
+		})
+	}
+}
+
+func TestClient_PrintFile_PDLDetection(t *testing.T) {
+	var gotPDL, gotContentType string
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/printers/printer-1/jobs":
+			gotPDL = r.URL.Query().Get("PDL")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":     true,
+				"job":         map[string]interface{}{"id": "job-1"},
+				"uploadLinks": []map[string]interface{}{{"url": server.URL + "/upload"}},
+				"_links": map[string]interface{}{
+					"uploadCompleted": map[string]interface{}{"href": server.URL + "/complete"},
+				},
+			})
+		case "/upload":
+			gotContentType = r.Header.Get("Content-Type")
+			w.WriteHeader(http.StatusCreated)
+		case "/complete":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	tests := []struct {
+		file            string
+		wantPDL         string
+		wantContentType string
+	}{
+		{"doc.pdf", "", "application/pdf"},
+		{"doc.xps", PDLXPS, "application/oxps"},
+		{"page.pwg", PDLPWGRaster, "image/pwg-raster"},
+		{"doc.ps", PDLPostScript, "application/postscript"},
+		{"LABEL.ZPL", PDLZPL, zplContentType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			require.NoError(t, os.WriteFile(path, []byte("data"), 0o600))
+
+			require.NoError(t, client.PrintFile(context.Background(), "printer-1", "Doc", path, nil))
+			assert.Equal(t, tt.wantPDL, gotPDL)
+			assert.Equal(t, tt.wantContentType, gotContentType)
+		})
+	}
+}
+
+func TestClient_Submit_APIVersion(t *testing.T) {
+	var gotVersion string
+	var gotBody []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+		gotVersion = r.Header.Get("version")
+		gotBody, _ = io.ReadAll(r.Body)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+	copies := 2
+
+	tests := []struct {
+		name        string
+		job         *PrintJob
+		wantVersion string
+		wantBody    string
+		wantErr     string
+	}{
+		{name: "auto v1.0", job: &PrintJob{}, wantVersion: ""},
+		{name: "auto v1.1", job: &PrintJob{Copies: &copies}, wantVersion: "1.1", wantBody: `{"copies":2}`},
+		{name: "forced v1.1", job: &PrintJob{APIVersion: APIVersion11}, wantVersion: "1.1"},
+		{name: "forced v1.0", job: &PrintJob{APIVersion: APIVersion10, UseV11: true}, wantVersion: ""},
+		{name: "pinned future version", job: &PrintJob{APIVersion: "1.2", Copies: &copies}, wantVersion: "1.2", wantBody: `{"copies":2}`},
+		{name: "v1.0 with settings", job: &PrintJob{APIVersion: APIVersion10, Copies: &copies}, wantErr: "require API version 1.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotVersion, gotBody = "", nil
+			tt.job.PrinterID = "printer-1"
+
+			_, err := client.Submit(context.Background(), tt.job)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantVersion, gotVersion)
+			assert.Equal(t, tt.wantBody, strings.TrimSpace(string(gotBody)))
+		})
+	}
+}
+
+func TestClient_PrintFileByName(t *testing.T) {
+	var submitted []string
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/printers":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+				"printers": []map[string]interface{}{
+					{"id": "printer-1", "name": "Reception"},
+					{"id": "printer-2", "name": "Reception 2"},
+					{"id": "printer-3", "name": "Lab"},
+					{"id": "printer-4", "name": "Lab"},
+				},
+				"page": map[string]interface{}{"totalPages": 1},
+			})
+		case "/upload":
+			w.WriteHeader(http.StatusCreated)
+		case "/complete":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		default:
+			submitted = append(submitted, r.URL.Path)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":     true,
+				"job":         map[string]interface{}{"id": "job-1"},
+				"uploadLinks": []map[string]interface{}{{"url": server.URL + "/upload"}},
+				"_links": map[string]interface{}{
+					"uploadCompleted": map[string]interface{}{"href": server.URL + "/complete"},
+				},
+			})
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "doc.pdf")
+	require.NoError(t, os.WriteFile(path, []byte("%PDF"), 0o600))
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	require.NoError(t, client.PrintFileByName(context.Background(), "Reception", "Doc", path, nil))
+	assert.Equal(t, []string{"/cloudprint/tenants/test-tenant/printers/printer-1/jobs"}, submitted)
+
+	err := client.PrintFileByName(context.Background(), "Lab", "Doc", path, nil)
+	require.ErrorIs(t, err, ErrAmbiguousPrinterName)
+	assert.Contains(t, err.Error(), "printer-3, printer-4")
+
+	err = client.PrintFileByName(context.Background(), "Basement", "Doc", path, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+	assert.Len(t, submitted, 1)
+}
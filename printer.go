@@ -2,24 +2,29 @@ package printix
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 )
 
 // Printer represents a Printix printer.
 type Printer struct {
-	ID               string                 `json:"id"`
-	Name             string                 `json:"name"`
-	ConnectionStatus string                 `json:"connectionStatus,omitempty"`
-	PrinterSignID    string                 `json:"printerSignId,omitempty"`
-	Location         string                 `json:"location,omitempty"`
-	Model            string                 `json:"model,omitempty"`
-	Vendor           string                 `json:"vendor,omitempty"`
-	SerialNo         string                 `json:"serialNo,omitempty"`
-	Capabilities     PrinterCapabilities    `json:"capabilities,omitempty"`
-	Links            map[string]interface{} `json:"_links,omitempty"`
+	ID               string              `json:"id"`
+	Name             string              `json:"name"`
+	ConnectionStatus string              `json:"connectionStatus,omitempty"`
+	PrinterSignID    string              `json:"printerSignId,omitempty"`
+	Location         string              `json:"location,omitempty"`
+	Model            string              `json:"model,omitempty"`
+	Vendor           string              `json:"vendor,omitempty"`
+	SerialNo         string              `json:"serialNo,omitempty"`
+	Capabilities     PrinterCapabilities `json:"capabilities,omitempty"`
+	Links            HALLinks            `json:"_links,omitempty"`
 }
 
 // PrinterCapabilities represents printer capabilities.
@@ -28,18 +33,46 @@ type PrinterCapabilities struct {
 		MediaSize struct {
 			Option []MediaSizeOption `json:"option,omitempty"`
 		} `json:"media_size,omitempty"`
-		SupportedContentType []ContentType `json:"supported_content_type,omitempty"`
-		Copies               struct {
-			Default int `json:"default,omitempty"`
-			Max     int `json:"max,omitempty"`
-		} `json:"copies,omitempty"`
-		Color struct {
+		SupportedContentType []ContentType    `json:"supported_content_type,omitempty"`
+		Copies               CopiesCapability `json:"copies,omitempty"`
+		Color                struct {
 			Option []ColorOption `json:"option,omitempty"`
 		} `json:"color,omitempty"`
+		Duplex struct {
+			Option []DuplexOption `json:"option,omitempty"`
+		} `json:"duplex,omitempty"`
 		VendorCapability []VendorCapability `json:"vendor_capability,omitempty"`
 	} `json:"printer,omitempty"`
 }
 
+// CopiesCapability represents the copies capability of a printer.
+type CopiesCapability struct {
+	Default int `json:"default,omitempty"`
+	Max     int `json:"max,omitempty"`
+}
+
+// UnmarshalJSON decodes the capability, accepting the "defaultz" spelling of
+// the default that some API responses use.
+func (c *CopiesCapability) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Default  *int `json:"default"`
+		Defaultz *int `json:"defaultz"`
+		Max      int  `json:"max"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*c = CopiesCapability{Max: raw.Max}
+	switch {
+	case raw.Default != nil:
+		c.Default = *raw.Default
+	case raw.Defaultz != nil:
+		c.Default = *raw.Defaultz
+	}
+	return nil
+}
+
 // MediaSizeOption represents a media size option.
 type MediaSizeOption struct {
 	HeightMicrons    int    `json:"heightMicrons"`
@@ -61,6 +94,12 @@ type ColorOption struct {
 	Default bool   `json:"default"`
 }
 
+// DuplexOption represents a duplex option, e.g. "NO_DUPLEX" or "LONG_EDGE".
+type DuplexOption struct {
+	Type    string `json:"type"`
+	Default bool   `json:"default"`
+}
+
 // VendorCapability represents a vendor-specific capability.
 type VendorCapability struct {
 	ID                   string                 `json:"id"`
@@ -76,18 +115,41 @@ type LocalizedString struct {
 	Value  string `json:"value"`
 }
 
+// DisplayNameFor returns the display name localized for locale, e.g. "de-DE".
+// An exact locale match is preferred over a match on the language alone; the
+// unlocalized DisplayName is returned when nothing matches.
+func (v VendorCapability) DisplayNameFor(locale string) string {
+	locale = normalizeLocale(locale)
+	language, _, _ := strings.Cut(locale, "-")
+
+	fallback := ""
+	for _, localized := range v.DisplayNameLocalized {
+		candidate := normalizeLocale(localized.Locale)
+		if candidate == locale {
+			return localized.Value
+		}
+		if candidateLanguage, _, _ := strings.Cut(candidate, "-"); fallback == "" && candidateLanguage == language {
+			fallback = localized.Value
+		}
+	}
+	if fallback != "" {
+		return fallback
+	}
+	return v.DisplayName
+}
+
+// normalizeLocale lowercases a locale tag and uses "-" as separator.
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+}
+
 // PrintersResponse represents the HAL+JSON response from listing printers.
 type PrintersResponse struct {
-	Links    map[string]interface{} `json:"_links"`
-	Success  bool                   `json:"success"`
-	Message  string                 `json:"message"`
-	Printers []Printer              `json:"printers"`
-	Page     struct {
-		Size          int `json:"size"`
-		TotalElements int `json:"totalElements"`
-		TotalPages    int `json:"totalPages"`
-		Number        int `json:"number"`
-	} `json:"page"`
+	Links    HALLinks  `json:"_links"`
+	Success  bool      `json:"success"`
+	Message  string    `json:"message"`
+	Printers []Printer `json:"printers"`
+	Page     PageInfo  `json:"page"`
 }
 
 // GetPrintersOptions represents options for listing printers.
@@ -145,31 +207,174 @@ func (c *Client) GetAllPrinters(ctx context.Context, query string) ([]Printer, e
 	page := 0
 	pageSize := 100 // Use a larger page size for efficiency
 
+	resp, err := c.GetPrinters(ctx, &GetPrintersOptions{Query: query, PageSize: pageSize})
+	if err != nil {
+		return nil, fmt.Errorf("getting printers page %d: %w", page, err)
+	}
+
 	for {
-		opts := &GetPrintersOptions{
-			Query:    query,
-			Page:     page,
-			PageSize: pageSize,
+		allPrinters = append(allPrinters, resp.Printers...)
+		if len(resp.Printers) == 0 {
+			break
 		}
+		page++
 
-		resp, err := c.GetPrinters(ctx, opts)
-		if err != nil {
-			return nil, fmt.Errorf("getting printers page %d: %w", page, err)
+		// Prefer the server's next link and fall back to page arithmetic
+		if _, ok := resp.Links.Href("next"); ok {
+			var next PrintersResponse
+			if err := c.followLink(ctx, resp.Links, "next", &next); err != nil {
+				return nil, fmt.Errorf("getting printers page %d: %w", page, err)
+			}
+			if !next.Success {
+				return nil, fmt.Errorf("getting printers page %d: get printers failed: %s", page, next.Message)
+			}
+			resp = &next
+			continue
 		}
 
-		allPrinters = append(allPrinters, resp.Printers...)
-
 		// Check if we've reached the last page
-		if page >= resp.Page.TotalPages-1 || len(resp.Printers) == 0 {
+		if page > resp.Page.TotalPages-1 {
 			break
 		}
 
-		page++
+		resp, err = c.GetPrinters(ctx, &GetPrintersOptions{Query: query, Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, fmt.Errorf("getting printers page %d: %w", page, err)
+		}
 	}
 
 	return allPrinters, nil
 }
 
+// GetAllPrintersConcurrent retrieves all printers like GetAllPrinters, but
+// fetches the pages after the first with up to workers concurrent requests.
+// Printers are returned in page order. Requests honour WithRateLimitGuard,
+// and the first failing page cancels the remaining ones.
+func (c *Client) GetAllPrintersConcurrent(ctx context.Context, query string, workers int) ([]Printer, error) {
+	workers = max(workers, 1)
+	pageSize := 100
+
+	first, err := c.GetPrinters(ctx, &GetPrintersOptions{Query: query, PageSize: pageSize})
+	if err != nil {
+		return nil, fmt.Errorf("getting printers page 0: %w", err)
+	}
+
+	pages := make([][]Printer, max(first.Page.TotalPages, 1))
+	pages[0] = first.Printers
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		errOnce  sync.Once
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	sem := make(chan struct{}, workers)
+	for page := 1; page < len(pages); page++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+			if ctx.Err() != nil {
+				return
+			}
+
+			resp, err := c.GetPrinters(ctx, &GetPrintersOptions{Query: query, Page: page, PageSize: pageSize})
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = fmt.Errorf("getting printers page %d: %w", page, err)
+					cancel()
+				})
+				return
+			}
+			pages[page] = resp.Printers
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("getting printers: %w", err)
+	}
+
+	var allPrinters []Printer
+	for _, printers := range pages {
+		allPrinters = append(allPrinters, printers...)
+	}
+	return allPrinters, nil
+}
+
+// PrinterPredicate reports whether a printer matches a condition.
+type PrinterPredicate func(*Printer) bool
+
+// FindPrinters retrieves all printers with GetAllPrinters and returns those
+// matching the predicate. A nil predicate matches every printer. An empty
+// slice is returned when no printer matches.
+func (c *Client) FindPrinters(ctx context.Context, pred PrinterPredicate) ([]Printer, error) {
+	printers, err := c.GetAllPrinters(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("getting printers: %w", err)
+	}
+
+	matches := []Printer{}
+	for i := range printers {
+		if pred == nil || pred(&printers[i]) {
+			matches = append(matches, printers[i])
+		}
+	}
+
+	return matches, nil
+}
+
+// PrinterSupportsColor matches printers that can print in color.
+func PrinterSupportsColor() PrinterPredicate {
+	return func(p *Printer) bool {
+		return p.SupportsColor()
+	}
+}
+
+// PrinterSupportsMedia matches printers that offer the given media size.
+func PrinterSupportsMedia(name string) PrinterPredicate {
+	return func(p *Printer) bool {
+		return p.SupportsMediaSize(name)
+	}
+}
+
+// PrinterAnd matches printers satisfying all predicates.
+// Evaluation stops at the first predicate that does not match.
+func PrinterAnd(preds ...PrinterPredicate) PrinterPredicate {
+	return func(p *Printer) bool {
+		for _, pred := range preds {
+			if !pred(p) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// PrinterOr matches printers satisfying at least one predicate.
+// Evaluation stops at the first predicate that matches.
+func PrinterOr(preds ...PrinterPredicate) PrinterPredicate {
+	return func(p *Printer) bool {
+		for _, pred := range preds {
+			if pred(p) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
 // GetPrinter retrieves details for a specific printer.
 func (c *Client) GetPrinter(ctx context.Context, printerID string) (*Printer, error) {
 	if c.tenantID == "" {
@@ -183,9 +388,9 @@ func (c *Client) GetPrinter(ctx context.Context, printerID string) (*Printer, er
 	}
 
 	var printerResp struct {
-		Links   map[string]interface{} `json:"_links"`
-		Success bool                   `json:"success"`
-		Message string                 `json:"message"`
+		Links   HALLinks `json:"_links"`
+		Success bool     `json:"success"`
+		Message string   `json:"message"`
 		Printer
 	}
 
@@ -214,6 +419,63 @@ func (c *Client) GetPrinter(ctx context.Context, printerID string) (*Printer, er
 	return &printer, nil
 }
 
+// GetPrinterCapabilities retrieves the capabilities of a printer. The API has
+// no separate capabilities resource, so this fetches the printer, honouring
+// the response cache.
+func (c *Client) GetPrinterCapabilities(ctx context.Context, printerID string) (*PrinterCapabilities, error) {
+	printer, err := c.GetPrinter(ctx, printerID)
+	if err != nil {
+		return nil, err
+	}
+	return &printer.Capabilities, nil
+}
+
+// WithPrinterCache caches printer responses for the given duration. It is
+// shorthand for WithCache with an unbounded MemoryCache and shares the cache
+// with tenant responses; if WithCache is also used, its store is kept. Use
+// InvalidatePrinterCache to force a refresh.
+func WithPrinterCache(ttl time.Duration) Option {
+	return func(c *Client) {
+		if c.cache == nil {
+			c.cache = NewMemoryCache(0)
+		}
+		c.cacheTTL = ttl
+	}
+}
+
+// InvalidatePrinterCache drops all cached printer responses.
+func (c *Client) InvalidatePrinterCache() {
+	c.invalidateResources("printers")
+}
+
+// ErrAmbiguousPrinterName is returned when more than one printer has the
+// requested name.
+var ErrAmbiguousPrinterName = errors.New("printer name is ambiguous")
+
+// resolvePrinterName returns the ID of the only printer named name.
+func (c *Client) resolvePrinterName(ctx context.Context, name string) (string, error) {
+	printers, err := c.GetAllPrinters(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("getting printers: %w", err)
+	}
+
+	var ids []string
+	for i := range printers {
+		if printers[i].Name == name {
+			ids = append(ids, printers[i].ID)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("printer with name %s not found", name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%w: %s matches printers %s", ErrAmbiguousPrinterName, name, strings.Join(ids, ", "))
+	}
+}
+
 // FindPrinterByName finds a printer by its name.
 func (c *Client) FindPrinterByName(ctx context.Context, name string) (*Printer, error) {
 	// Use the query parameter to search for the printer by name
@@ -233,6 +495,25 @@ func (c *Client) FindPrinterByName(ctx context.Context, name string) (*Printer,
 	return nil, fmt.Errorf("printer with name %s not found", name)
 }
 
+// FindPrintersByLocation finds printers whose location contains the given
+// text, ignoring case. An empty slice is returned when no printer matches.
+func (c *Client) FindPrintersByLocation(ctx context.Context, location string) ([]Printer, error) {
+	printers, err := c.GetAllPrinters(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("getting printers: %w", err)
+	}
+
+	needle := strings.ToLower(location)
+	matches := []Printer{}
+	for _, printer := range printers {
+		if strings.Contains(strings.ToLower(printer.Location), needle) {
+			matches = append(matches, printer)
+		}
+	}
+
+	return matches, nil
+}
+
 // SupportsContentType checks if a printer supports a specific content type.
 func (p *Printer) SupportsContentType(contentType string) bool {
 	for _, ct := range p.Capabilities.Printer.SupportedContentType {
@@ -242,3 +523,147 @@ func (p *Printer) SupportsContentType(contentType string) bool {
 	}
 	return false
 }
+
+// pdlContentTypes maps document MIME types to the PDL submitted with a job.
+// PDF is the API default and needs no PDL.
+var pdlContentTypes = map[string]string{
+	"application/pdf":                "",
+	"application/vnd.hp-pcl":         PDLPCL5,
+	"application/postscript":         PDLPostScript,
+	"application/vnd.ms-xpsdocument": PDLXPS,
+	"application/oxps":               PDLXPS,
+	zplContentType:                   PDLZPL,
+	"image/pwg-raster":               PDLPWGRaster,
+}
+
+// normalizeMIME lowercases a MIME type and strips any parameters.
+func normalizeMIME(mime string) string {
+	mime, _, _ = strings.Cut(mime, ";")
+	return strings.ToLower(strings.TrimSpace(mime))
+}
+
+// SupportedPDLs returns the distinct content types a printer accepts.
+func (p *Printer) SupportedPDLs() []string {
+	var pdls []string
+	for _, ct := range p.Capabilities.Printer.SupportedContentType {
+		if ct.ContentType != "" && !slices.Contains(pdls, ct.ContentType) {
+			pdls = append(pdls, ct.ContentType)
+		}
+	}
+	return pdls
+}
+
+// BestPDLFor returns the PDL to use when submitting a document of the given
+// MIME type to the printer. The PDL is empty for PDF documents. It reports
+// false when the printer does not accept the type or no PDL is known for it.
+func (p *Printer) BestPDLFor(mime string) (string, bool) {
+	mime = normalizeMIME(mime)
+	pdl, ok := pdlContentTypes[mime]
+	if !ok {
+		return "", false
+	}
+	for _, ct := range p.Capabilities.Printer.SupportedContentType {
+		if normalizeMIME(ct.ContentType) == mime {
+			return pdl, true
+		}
+	}
+	return "", false
+}
+
+// DefaultMediaSize returns the media size the printer marks as default.
+func (p *Printer) DefaultMediaSize() (MediaSizeOption, bool) {
+	for _, opt := range p.Capabilities.Printer.MediaSize.Option {
+		if opt.IsDefault {
+			return opt, true
+		}
+	}
+	return MediaSizeOption{}, false
+}
+
+// DefaultColorMode returns the color option the printer marks as default.
+func (p *Printer) DefaultColorMode() (ColorOption, bool) {
+	for _, opt := range p.Capabilities.Printer.Color.Option {
+		if opt.Default {
+			return opt, true
+		}
+	}
+	return ColorOption{}, false
+}
+
+// PrinterStatusOnline is the ConnectionStatus of a reachable printer.
+const PrinterStatusOnline = "ONLINE"
+
+// IsOnline reports whether the printer is connected.
+func (p *Printer) IsOnline() bool {
+	return strings.EqualFold(p.ConnectionStatus, PrinterStatusOnline)
+}
+
+// SupportsColor checks if a printer offers a color print mode.
+func (p *Printer) SupportsColor() bool {
+	for _, opt := range p.Capabilities.Printer.Color.Option {
+		if strings.HasSuffix(opt.Type, "_COLOR") {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportsDuplex checks if a printer offers a two-sided print mode.
+func (p *Printer) SupportsDuplex() bool {
+	for _, opt := range p.Capabilities.Printer.Duplex.Option {
+		if opt.Type != "" && opt.Type != "NO_DUPLEX" {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportsMediaSize checks if a printer offers a media size with the given name.
+func (p *Printer) SupportsMediaSize(name string) bool {
+	for _, opt := range p.Capabilities.Printer.MediaSize.Option {
+		if strings.EqualFold(opt.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrinterCapabilitySummary is a flat view of a printer's capabilities for
+// reporting.
+type PrinterCapabilitySummary struct {
+	PrinterID  string   `json:"printerId"`
+	Name       string   `json:"name"`
+	Location   string   `json:"location,omitempty"`
+	Color      bool     `json:"color"`
+	Duplex     bool     `json:"duplex"`
+	MaxCopies  int      `json:"maxCopies,omitempty"`
+	MediaSizes []string `json:"mediaSizes"`
+}
+
+// CapabilityReport summarizes the capabilities of all printers.
+func (c *Client) CapabilityReport(ctx context.Context) ([]PrinterCapabilitySummary, error) {
+	printers, err := c.GetAllPrinters(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("building capability report: %w", err)
+	}
+
+	report := make([]PrinterCapabilitySummary, 0, len(printers))
+	for i := range printers {
+		p := &printers[i]
+		summary := PrinterCapabilitySummary{
+			PrinterID:  p.ID,
+			Name:       p.Name,
+			Location:   p.Location,
+			Color:      p.SupportsColor(),
+			Duplex:     p.SupportsDuplex(),
+			MaxCopies:  p.Capabilities.Printer.Copies.Max,
+			MediaSizes: []string{},
+		}
+		for _, opt := range p.Capabilities.Printer.MediaSize.Option {
+			summary.MediaSizes = append(summary.MediaSizes, opt.Name)
+		}
+		report = append(report, summary)
+	}
+
+	return report, nil
+}
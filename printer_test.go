@@ -0,0 +1,548 @@
+package printix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_FindPrinters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/printers":
+			printers := []map[string]interface{}{
+				{
+					"id":   "color-a3",
+					"name": "Color A3",
+					"capabilities": map[string]interface{}{
+						"printer": map[string]interface{}{
+							"color":      map[string]interface{}{"option": []map[string]interface{}{{"type": "STANDARD_COLOR"}, {"type": "STANDARD_MONOCHROME"}}},
+							"media_size": map[string]interface{}{"option": []map[string]interface{}{{"name": "A4"}, {"name": "A3"}}},
+						},
+					},
+				},
+				{
+					"id":   "mono-a3",
+					"name": "Mono A3",
+					"capabilities": map[string]interface{}{
+						"printer": map[string]interface{}{
+							"color":      map[string]interface{}{"option": []map[string]interface{}{{"type": "STANDARD_MONOCHROME"}}},
+							"media_size": map[string]interface{}{"option": []map[string]interface{}{{"name": "A3"}}},
+						},
+					},
+				},
+			}
+			if r.URL.Query().Get("page") == "1" {
+				printers = []map[string]interface{}{
+					{
+						"id":   "color-a4",
+						"name": "Color A4",
+						"capabilities": map[string]interface{}{
+							"printer": map[string]interface{}{
+								"color":      map[string]interface{}{"option": []map[string]interface{}{{"type": "STANDARD_COLOR"}}},
+								"media_size": map[string]interface{}{"option": []map[string]interface{}{{"name": "A4"}}},
+							},
+						},
+					},
+				}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":  true,
+				"printers": printers,
+				"page":     map[string]interface{}{"totalPages": 2},
+			})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	tests := []struct {
+		name    string
+		pred    PrinterPredicate
+		wantIDs []string
+	}{
+		{
+			name:    "color and A3",
+			pred:    PrinterAnd(PrinterSupportsColor(), PrinterSupportsMedia("A3")),
+			wantIDs: []string{"color-a3"},
+		},
+		{
+			name:    "color or A3",
+			pred:    PrinterOr(PrinterSupportsColor(), PrinterSupportsMedia("a3")),
+			wantIDs: []string{"color-a3", "mono-a3", "color-a4"},
+		},
+		{
+			name:    "no match",
+			pred:    PrinterSupportsMedia("LETTER"),
+			wantIDs: []string{},
+		},
+		{
+			name:    "nil predicate matches all",
+			wantIDs: []string{"color-a3", "mono-a3", "color-a4"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.FindPrinters(context.Background(), tt.pred)
+			require.NoError(t, err)
+
+			ids := []string{}
+			for _, p := range got {
+				ids = append(ids, p.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+func TestClient_FindPrintersByLocation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/printers":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+				"printers": []map[string]interface{}{
+					{"id": "hq-2", "location": "HQ Building, 2nd Floor"},
+					{"id": "hq-3", "location": "hq building, 3rd floor"},
+					{"id": "branch", "location": "Branch Office"},
+					{"id": "unknown"},
+				},
+				"page": map[string]interface{}{"totalPages": 1},
+			})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	tests := []struct {
+		name     string
+		location string
+		wantIDs  []string
+	}{
+		{
+			name:     "case-insensitive substring",
+			location: "Hq BUILDING",
+			wantIDs:  []string{"hq-2", "hq-3"},
+		},
+		{
+			name:     "single match",
+			location: "office",
+			wantIDs:  []string{"branch"},
+		},
+		{
+			name:     "no match",
+			location: "Warehouse",
+			wantIDs:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.FindPrintersByLocation(context.Background(), tt.location)
+			require.NoError(t, err)
+			require.NotNil(t, got)
+
+			ids := []string{}
+			for _, p := range got {
+				ids = append(ids, p.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+func TestClient_GetAllPrinters_NextLink(t *testing.T) {
+	var requested []string
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+
+		requested = append(requested, r.URL.RequestURI())
+		resp := map[string]interface{}{
+			"success":  true,
+			"printers": []map[string]interface{}{{"id": "printer-1"}},
+			"page":     map[string]interface{}{"totalPages": 1},
+			"_links": map[string]interface{}{
+				"next": map[string]interface{}{"href": server.URL + "/cloudprint/tenants/test-tenant/printers?cursor=2"},
+			},
+		}
+		if r.URL.Query().Get("cursor") == "2" {
+			resp["printers"] = []map[string]interface{}{{"id": "printer-2"}}
+			resp["_links"] = map[string]interface{}{}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	printers, err := client.GetAllPrinters(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, printers, 2)
+	assert.Equal(t, "printer-2", printers[1].ID)
+	assert.Equal(t, []string{
+		"/cloudprint/tenants/test-tenant/printers?pageSize=100",
+		"/cloudprint/tenants/test-tenant/printers?cursor=2",
+	}, requested)
+}
+
+func TestClient_PrinterCache(t *testing.T) {
+	var listRequests, getRequests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/printers":
+			listRequests++
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":  true,
+				"printers": []map[string]interface{}{{"id": "printer-1", "name": "Office"}},
+				"page":     map[string]interface{}{"totalPages": 1},
+			})
+		case "/cloudprint/tenants/test-tenant/printers/printer-1":
+			getRequests++
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+				"id":      "printer-1",
+				"name":    "Office",
+			})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"), WithPrinterCache(time.Minute))
+
+	for range 3 {
+		printers, err := client.GetAllPrinters(context.Background(), "")
+		require.NoError(t, err)
+		require.Len(t, printers, 1)
+
+		printer, err := client.GetPrinter(context.Background(), "printer-1")
+		require.NoError(t, err)
+		assert.Equal(t, "Office", printer.Name)
+	}
+	assert.Equal(t, 1, listRequests)
+	assert.Equal(t, 1, getRequests)
+
+	client.InvalidatePrinterCache()
+	_, err := client.GetAllPrinters(context.Background(), "")
+	require.NoError(t, err)
+	_, err = client.GetPrinter(context.Background(), "printer-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, listRequests)
+	assert.Equal(t, 2, getRequests)
+
+	// With WithCache, printers share the pluggable store and its invalidation
+	cache := NewMemoryCache(10)
+	client = New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"), WithCache(cache, time.Minute), WithPrinterCache(time.Minute))
+	assert.Same(t, cache, client.cache)
+
+	_, err = client.GetPrinter(context.Background(), "printer-1")
+	require.NoError(t, err)
+	_, err = client.GetPrinter(context.Background(), "printer-1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, getRequests)
+
+	client.InvalidatePrinterCache()
+	_, err = client.GetPrinter(context.Background(), "printer-1")
+	require.NoError(t, err)
+	assert.Equal(t, 4, getRequests)
+}
+
+func TestPrinter_BestPDLFor(t *testing.T) {
+	printer := &Printer{}
+	printer.Capabilities.Printer.SupportedContentType = []ContentType{
+		{ContentType: "application/pdf"},
+		{ContentType: "application/postscript"},
+		{ContentType: "application/vnd.hp-PCL"},
+		{ContentType: "application/pdf", MinVersion: "1.5"},
+		{ContentType: "image/pwg-raster"},
+		{ContentType: "image/urf"},
+	}
+
+	assert.Equal(t, []string{"application/pdf", "application/postscript", "application/vnd.hp-PCL", "image/pwg-raster", "image/urf"}, printer.SupportedPDLs())
+
+	tests := []struct {
+		name    string
+		mime    string
+		wantPDL string
+		wantOK  bool
+	}{
+		{name: "pdf", mime: "application/pdf", wantPDL: "", wantOK: true},
+		{name: "postscript", mime: "application/postscript", wantPDL: "POSTSCRIPT", wantOK: true},
+		{name: "case and parameters", mime: "Application/VND.HP-PCL; charset=binary", wantPDL: "PCL5", wantOK: true},
+		{name: "unsupported by printer", mime: "application/vnd.ms-xpsdocument", wantOK: false},
+		{name: "pwg raster", mime: "image/pwg-raster", wantPDL: "PWG_RASTER", wantOK: true},
+		{name: "no known pdl", mime: "image/urf", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pdl, ok := printer.BestPDLFor(tt.mime)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantPDL, pdl)
+		})
+	}
+}
+
+func TestPrinter_Defaults(t *testing.T) {
+	printer := &Printer{}
+
+	_, ok := printer.DefaultMediaSize()
+	assert.False(t, ok)
+	_, ok = printer.DefaultColorMode()
+	assert.False(t, ok)
+
+	printer.Capabilities.Printer.MediaSize.Option = []MediaSizeOption{
+		{Name: "A3"},
+		{Name: "A4", IsDefault: true},
+	}
+	printer.Capabilities.Printer.Color.Option = []ColorOption{
+		{Type: "STANDARD_COLOR"},
+		{Type: "STANDARD_MONOCHROME", Default: true},
+	}
+
+	media, ok := printer.DefaultMediaSize()
+	require.True(t, ok)
+	assert.Equal(t, "A4", media.Name)
+
+	color, ok := printer.DefaultColorMode()
+	require.True(t, ok)
+	assert.Equal(t, "STANDARD_MONOCHROME", color.Type)
+}
+
+func TestVendorCapability_DisplayNameFor(t *testing.T) {
+	capability := VendorCapability{
+		DisplayName: "Stapling",
+		DisplayNameLocalized: []LocalizedString{
+			{Locale: "de_DE", Value: "Heften"},
+			{Locale: "de-AT", Value: "Klammern"},
+			{Locale: "fr", Value: "Agrafage"},
+		},
+	}
+
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{locale: "de-DE", want: "Heften"},
+		{locale: "DE-at", want: "Klammern"},
+		{locale: "de-CH", want: "Heften"},
+		{locale: "fr-CA", want: "Agrafage"},
+		{locale: "ja", want: "Stapling"},
+		{locale: "", want: "Stapling"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			assert.Equal(t, tt.want, capability.DisplayNameFor(tt.locale))
+		})
+	}
+}
+
+func TestClient_GetPrinterCapabilities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"id":      "printer-1",
+			"capabilities": map[string]interface{}{
+				"printer": map[string]interface{}{
+					"copies":     map[string]interface{}{"default": 1, "max": 99},
+					"media_size": map[string]interface{}{"option": []map[string]interface{}{{"name": "A4", "isDefault": true}}},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	capabilities, err := client.GetPrinterCapabilities(context.Background(), "printer-1")
+	require.NoError(t, err)
+	assert.Equal(t, 99, capabilities.Printer.Copies.Max)
+	require.Len(t, capabilities.Printer.MediaSize.Option, 1)
+	assert.Equal(t, "A4", capabilities.Printer.MediaSize.Option[0].Name)
+}
+
+func TestClient_CapabilityReport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"printers": []map[string]interface{}{
+				{
+					"id":       "office",
+					"name":     "Office",
+					"location": "Floor 1",
+					"capabilities": map[string]interface{}{
+						"printer": map[string]interface{}{
+							"color":      map[string]interface{}{"option": []map[string]interface{}{{"type": "STANDARD_COLOR"}}},
+							"duplex":     map[string]interface{}{"option": []map[string]interface{}{{"type": "NO_DUPLEX"}, {"type": "LONG_EDGE"}}},
+							"copies":     map[string]interface{}{"max": 99},
+							"media_size": map[string]interface{}{"option": []map[string]interface{}{{"name": "A4"}, {"name": "A3"}}},
+						},
+					},
+				},
+				{"id": "label", "name": "Label"},
+			},
+			"page": map[string]interface{}{"totalPages": 1},
+		})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	report, err := client.CapabilityReport(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []PrinterCapabilitySummary{
+		{PrinterID: "office", Name: "Office", Location: "Floor 1", Color: true, Duplex: true, MaxCopies: 99, MediaSizes: []string{"A4", "A3"}},
+		{PrinterID: "label", Name: "Label", MediaSizes: []string{}},
+	}, report)
+}
+
+func TestClient_GetAllPrintersConcurrent(t *testing.T) {
+	const totalPages = 6
+	var inFlight, maxInFlight atomic.Int32
+	var failPage atomic.Int32
+	failPage.Store(-1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if int32(page) == failPage.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"printers": []map[string]interface{}{
+				{"id": fmt.Sprintf("printer-%d-a", page)},
+				{"id": fmt.Sprintf("printer-%d-b", page)},
+			},
+			"page": map[string]interface{}{"totalPages": totalPages, "number": page},
+		})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"),
+		WithTenantID("test-tenant"), WithMaxRetries(0))
+
+	printers, err := client.GetAllPrintersConcurrent(context.Background(), "", 2)
+	require.NoError(t, err)
+	require.Len(t, printers, totalPages*2)
+	for i, printer := range printers {
+		assert.Equal(t, fmt.Sprintf("printer-%d-%c", i/2, 'a'+i%2), printer.ID)
+	}
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+
+	failPage.Store(3)
+	_, err = client.GetAllPrintersConcurrent(context.Background(), "", 2)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "page 3")
+}
+
+func TestPrinter_JSONRoundTrip(t *testing.T) {
+	// Shaped like a GET /cloudprint/tenants/{tenantId}/printers/{printerId} response,
+	// including the "defaultz" spelling of the copies default
+	payload := `{
+		"id": "581cd264-ccda-4407-b0c5-fb87bd42e95f",
+		"name": "Reception",
+		"connectionStatus": "ONLINE",
+		"location": "Floor 1",
+		"model": "MFC-L8900CDW",
+		"vendor": "Brother",
+		"capabilities": {
+			"printer": {
+				"media_size": {"option": [
+					{"heightMicrons": 297000, "widthMicrons": 210000, "name": "ISO_A4", "isContinuousFeed": false, "isDefault": true}
+				]},
+				"supported_content_type": [{"content_type": "application/pdf", "min_version": "1.5"}],
+				"copies": {"defaultz": 1, "max": 999},
+				"color": {"option": [{"type": "STANDARD_COLOR", "default": true}, {"type": "STANDARD_MONOCHROME"}]},
+				"duplex": {"option": [{"type": "NO_DUPLEX", "default": true}, {"type": "LONG_EDGE"}]}
+			}
+		},
+		"_links": {"self": {"href": "https://api.printix.net/cloudprint/tenants/t/printers/581cd264-ccda-4407-b0c5-fb87bd42e95f"}}
+	}`
+
+	var printer Printer
+	require.NoError(t, json.Unmarshal([]byte(payload), &printer))
+	assert.Equal(t, CopiesCapability{Default: 1, Max: 999}, printer.Capabilities.Printer.Copies)
+	assert.True(t, printer.SupportsColor())
+	assert.True(t, printer.SupportsDuplex())
+
+	encoded, err := json.Marshal(printer)
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), `"copies":{"default":1,"max":999}`)
+
+	var decoded Printer
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, printer, decoded)
+
+	var copies CopiesCapability
+	require.NoError(t, json.Unmarshal([]byte(`{"default": 2, "defaultz": 1}`), &copies))
+	assert.Equal(t, 2, copies.Default)
+}
@@ -0,0 +1,89 @@
+package printix
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultMaxRetries = 3
+	retryBaseDelay    = 200 * time.Millisecond
+	retryMaxDelay     = 5 * time.Second
+)
+
+// WithMaxRetries sets how often a request is retried after a transient
+// network error. The default is 3; zero disables retries.
+func WithMaxRetries(maxRetries int) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+	}
+}
+
+// WithRetryPOST allows POST requests to be retried after transient network
+// errors. It is disabled by default because a retried job submission may
+// create a duplicate job if the first attempt reached the server.
+func WithRetryPOST() Option {
+	return func(c *Client) {
+		c.retryPOST = true
+	}
+}
+
+// canRetry reports whether a failed request may be sent again.
+func (c *Client) canRetry(ctx context.Context, method string, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+	case http.MethodPost:
+		if !c.retryPOST {
+			return false
+		}
+	default:
+		return false
+	}
+
+	return isTransientError(err)
+}
+
+// isTransientError reports whether an error is a network error that is
+// likely to succeed on retry, such as a timeout or a connection reset.
+// Timeouts of a single attempt, e.g. http.Client.Timeout, wrap
+// context.DeadlineExceeded as well, so whether the caller's context has
+// ended is left to canRetry.
+func isTransientError(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// sleepBackoff waits before the next retry using exponential backoff with
+// full jitter.
+func sleepBackoff(ctx context.Context, attempt int) error {
+	delay := min(retryBaseDelay<<attempt, retryMaxDelay)
+	delay = time.Duration(rand.Int64N(int64(delay))) + 1
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,151 @@
+package printix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyTransport fails the first requests to API paths with a connection reset.
+type flakyTransport struct {
+	failures int
+	attempts int
+}
+
+func (f *flakyTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if strings.HasPrefix(r.URL.Path, "/cloudprint") {
+		f.attempts++
+		if f.attempts <= f.failures {
+			return nil, syscall.ECONNRESET
+		}
+	}
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestClient_Retry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		opts         []Option
+		call         func(c *Client) error
+		wantErr      bool
+		wantAttempts int
+	}{
+		{
+			name: "GET succeeds after two failures",
+			call: func(c *Client) error {
+				_, err := c.GetTenants(context.Background())
+				return err
+			},
+			wantAttempts: 3,
+		},
+		{
+			name: "GET gives up after max retries",
+			opts: []Option{WithMaxRetries(1)},
+			call: func(c *Client) error {
+				_, err := c.GetTenants(context.Background())
+				return err
+			},
+			wantErr:      true,
+			wantAttempts: 2,
+		},
+		{
+			name: "POST is not retried by default",
+			call: func(c *Client) error {
+				return c.CancelJob(context.Background(), "job-1")
+			},
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+		{
+			name: "POST is retried when enabled",
+			opts: []Option{WithRetryPOST()},
+			call: func(c *Client) error {
+				return c.CancelJob(context.Background(), "job-1")
+			},
+			wantAttempts: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &flakyTransport{failures: 2}
+			opts := append([]Option{
+				WithBaseURL(server.URL),
+				WithAuthURL(server.URL + "/oauth/token"),
+				WithTenantID("test-tenant"),
+				WithHTTPClient(&http.Client{Transport: transport}),
+			}, tt.opts...)
+			client := New("test-id", "test-secret", opts...)
+
+			err := tt.call(client)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, syscall.ECONNRESET)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantAttempts, transport.attempts)
+		})
+	}
+}
+
+func TestClient_RetryAttemptTimeout(t *testing.T) {
+	var attempts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		default:
+			// The first attempt stalls past the client timeout
+			if attempts.Add(1) == 1 {
+				time.Sleep(200 * time.Millisecond)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret",
+		WithBaseURL(server.URL),
+		WithAuthURL(server.URL+"/oauth/token"),
+		WithHTTPClient(&http.Client{Timeout: 50 * time.Millisecond}),
+	)
+
+	_, err := client.GetTenants(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), attempts.Load())
+
+	// A done caller context still ends the retries
+	attempts.Store(0)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.GetTenants(ctx)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -4,23 +4,26 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"path"
+	"strings"
+	"time"
 )
 
 // Tenant represents a Printix tenant.
 type Tenant struct {
-	ID          string                 `json:"id"`
-	Name        string                 `json:"name"`
-	Description string                 `json:"description,omitempty"`
-	Properties  map[string]any         `json:"properties,omitempty"`
-	Links       map[string]interface{} `json:"_links,omitempty"`
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Properties  map[string]any `json:"properties,omitempty"`
+	Links       HALLinks       `json:"_links,omitempty"`
 }
 
 // TenantsResponse represents the HAL+JSON response from the root endpoint.
 type TenantsResponse struct {
-	Links    map[string]interface{} `json:"_links"`
-	Success  bool                   `json:"success"`
-	Message  string                 `json:"message,omitempty"`
-	Tenants  []Tenant               `json:"tenants"`
+	Links   HALLinks `json:"_links"`
+	Success bool     `json:"success"`
+	Message string   `json:"message,omitempty"`
+	Tenants []Tenant `json:"tenants"`
 }
 
 // GetTenants retrieves the list of accessible tenants for the authenticated client.
@@ -43,8 +46,122 @@ func (c *Client) GetTenants(ctx context.Context) (*TenantsResponse, error) {
 	return &tenantsResp, nil
 }
 
+// GetTenant retrieves details for a specific tenant.
+func (c *Client) GetTenant(ctx context.Context, tenantID string) (*Tenant, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/cloudprint/tenants/"+tenantID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting tenant: %w", err)
+	}
+
+	var tenantResp struct {
+		Success bool   `json:"success"`
+		Message string `json:"message,omitempty"`
+		Tenant
+	}
+
+	if err := parseResponse(resp, &tenantResp); err != nil {
+		return nil, fmt.Errorf("parsing tenant response: %w", err)
+	}
+
+	if !tenantResp.Success {
+		return nil, fmt.Errorf("get tenant failed: %s", tenantResp.Message)
+	}
+
+	tenant := tenantResp.Tenant
+	if tenant.ID == "" {
+		tenant.ID = tenantID
+	}
+
+	return &tenant, nil
+}
+
+// FindTenantByName finds an accessible tenant by name, ignoring case.
+func (c *Client) FindTenantByName(ctx context.Context, name string) (*Tenant, error) {
+	tenantsResp, err := c.GetTenants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tenant := range tenantsResp.Tenants {
+		if strings.EqualFold(tenant.Name, name) {
+			return &tenant, nil
+		}
+	}
+
+	// The root endpoint may only link to tenants, so look at each one.
+	if len(tenantsResp.Tenants) == 0 {
+		for _, id := range tenantsResp.tenantIDs() {
+			tenant, err := c.GetTenant(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if strings.EqualFold(tenant.Name, name) {
+				return tenant, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("tenant with name %s not found", name)
+}
+
 // SetTenant sets the active tenant for subsequent API calls.
 // This is useful when the client has access to multiple tenants.
 func (c *Client) SetTenant(tenantID string) {
 	c.tenantID = tenantID
-}
\ No newline at end of file
+}
+
+// AutoSelectTenant selects the only tenant the client has access to and
+// returns its ID. It fails if no tenant or more than one tenant is available.
+func (c *Client) AutoSelectTenant(ctx context.Context) (string, error) {
+	tenantsResp, err := c.GetTenants(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	ids := tenantsResp.tenantIDs()
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no tenants available")
+	case 1:
+		c.SetTenant(ids[0])
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d tenants available, select one with SetTenant", len(ids))
+	}
+}
+
+// tenantIDs returns the IDs of the listed tenants. The root endpoint may only
+// link to tenants, in which case the IDs are taken from the tenant links.
+func (r *TenantsResponse) tenantIDs() []string {
+	var ids []string
+	for _, tenant := range r.Tenants {
+		ids = append(ids, tenant.ID)
+	}
+	if len(ids) > 0 {
+		return ids
+	}
+
+	for _, href := range r.Links.Hrefs("tenants") {
+		ids = append(ids, path.Base(href))
+	}
+	return ids
+}
+
+// WithTenantCache caches tenant responses for the given duration. It is
+// shorthand for WithCache with an unbounded MemoryCache and shares the cache
+// with printer responses; if WithCache is also used, its store is kept. Use
+// InvalidateTenantCache to force a refresh.
+func WithTenantCache(ttl time.Duration) Option {
+	return func(c *Client) {
+		if c.cache == nil {
+			c.cache = NewMemoryCache(0)
+		}
+		c.cacheTTL = ttl
+	}
+}
+
+// InvalidateTenantCache drops all cached tenant responses, including the
+// tenant list.
+func (c *Client) InvalidateTenantCache() {
+	c.invalidateResources("cloudprint", "tenants")
+}
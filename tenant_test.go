@@ -0,0 +1,149 @@
+package printix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_AutoSelectTenant(t *testing.T) {
+	tests := []struct {
+		name       string
+		response   map[string]interface{}
+		wantTenant string
+		wantErr    string
+	}{
+		{
+			name: "single tenant link",
+			response: map[string]interface{}{
+				"success": true,
+				"_links": map[string]interface{}{
+					"tenants": []map[string]interface{}{
+						{"href": "https://api.printix.net/cloudprint/tenants/b54aed12-c905-4dd1-a69f-5b34aec43533"},
+					},
+				},
+			},
+			wantTenant: "b54aed12-c905-4dd1-a69f-5b34aec43533",
+		},
+		{
+			name: "single tenant object",
+			response: map[string]interface{}{
+				"success": true,
+				"tenants": []map[string]interface{}{{"id": "tenant-1", "name": "Acme"}},
+			},
+			wantTenant: "tenant-1",
+		},
+		{
+			name:     "no tenants",
+			response: map[string]interface{}{"success": true},
+			wantErr:  "no tenants available",
+		},
+		{
+			name: "multiple tenants",
+			response: map[string]interface{}{
+				"success": true,
+				"tenants": []map[string]interface{}{{"id": "tenant-1"}, {"id": "tenant-2"}},
+			},
+			wantErr: "2 tenants available",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/oauth/token" {
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{
+						"access_token": "test-token",
+						"expires_in":   3600,
+					})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tt.response)
+			}))
+			defer server.Close()
+
+			client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"))
+			tenantID, err := client.AutoSelectTenant(context.Background())
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Empty(t, client.GetTenantID())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTenant, tenantID)
+			assert.Equal(t, tt.wantTenant, client.GetTenantID())
+		})
+	}
+}
+
+func TestClient_TenantLookup(t *testing.T) {
+	var rootRequests, tenantRequests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint":
+			rootRequests++
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+				"_links": map[string]interface{}{
+					"tenants": []map[string]interface{}{
+						{"href": "http://" + r.Host + "/cloudprint/tenants/tenant-1"},
+						{"href": "http://" + r.Host + "/cloudprint/tenants/tenant-2"},
+					},
+				},
+			})
+		case "/cloudprint/tenants/tenant-1", "/cloudprint/tenants/tenant-2":
+			tenantRequests++
+			names := map[string]string{"/cloudprint/tenants/tenant-1": "Acme", "/cloudprint/tenants/tenant-2": "Globex"}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+				"name":    names[r.URL.Path],
+			})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": false, "message": "Tenant not found"})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantCache(time.Minute))
+	ctx := context.Background()
+
+	tenant, err := client.GetTenant(ctx, "tenant-1")
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-1", tenant.ID)
+	assert.Equal(t, "Acme", tenant.Name)
+
+	tenant, err = client.FindTenantByName(ctx, "globex")
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-2", tenant.ID)
+
+	_, err = client.FindTenantByName(ctx, "Initech")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+
+	// The list and both tenants were served from the cache after the first fetch.
+	assert.Equal(t, 1, rootRequests)
+	assert.Equal(t, 2, tenantRequests)
+
+	client.InvalidateTenantCache()
+	_, err = client.GetTenant(ctx, "tenant-1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, tenantRequests)
+
+	_, err = client.GetTenant(ctx, "missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Tenant not found")
+}
@@ -0,0 +1,159 @@
+package printix
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// TokenStore persists access tokens so they can be reused across clients.
+type TokenStore interface {
+	Load() (token string, expiry time.Time, err error)
+	Save(token string, expiry time.Time) error
+}
+
+// WithTokenStore sets a store consulted before requesting a new access token.
+// Freshly obtained tokens are saved to the store.
+func WithTokenStore(s TokenStore) Option {
+	return func(c *Client) {
+		c.tokenStore = s
+	}
+}
+
+// WithAccessToken seeds the client with an access token obtained elsewhere.
+// No token is requested while it is valid. Once it expires the client falls
+// back to its credentials, or fails if none were supplied.
+func WithAccessToken(token string, expiry time.Time) Option {
+	return func(c *Client) {
+		c.accessToken = token
+		c.tokenExpiry = expiry
+	}
+}
+
+// WithOnTokenRefresh sets a callback invoked after a new access token was
+// obtained from the auth server. It runs synchronously on the request path
+// while the token lock is held, so it must return quickly and must not call
+// back into the client.
+func WithOnTokenRefresh(fn func(token string, expiry time.Time)) Option {
+	return func(c *Client) {
+		c.onTokenRefresh = fn
+	}
+}
+
+// TokenExpiry returns the expiry of the cached access token, or the zero time
+// if there is none. It never triggers a token request.
+func (c *Client) TokenExpiry() time.Time {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	return c.tokenExpiry
+}
+
+// TokenValid reports whether the cached access token can be used without
+// renewal, taking the renewal skew into account. It never triggers a token
+// request.
+func (c *Client) TokenValid() bool {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	return c.tokenValid(c.accessToken, c.tokenExpiry)
+}
+
+// loadStoredToken adopts a still valid token from the token store.
+// The caller must hold tokenMu.
+func (c *Client) loadStoredToken(ctx context.Context) bool {
+	if c.tokenStore == nil {
+		return false
+	}
+
+	token, expiry, err := c.tokenStore.Load()
+	if err != nil {
+		c.logDebug(ctx, "printix token store load failed", "error", err)
+		return false
+	}
+	if !c.tokenValid(token, expiry) {
+		return false
+	}
+
+	c.accessToken = token
+	c.tokenExpiry = expiry
+	return true
+}
+
+// saveToken persists the current token. The caller must hold tokenMu.
+func (c *Client) saveToken(ctx context.Context) {
+	if c.tokenStore == nil {
+		return
+	}
+
+	if err := c.tokenStore.Save(c.accessToken, c.tokenExpiry); err != nil {
+		c.logDebug(ctx, "printix token store save failed", "error", err)
+	}
+}
+
+// FileTokenStore is a TokenStore backed by a JSON file.
+type FileTokenStore struct {
+	path string
+}
+
+// NewFileTokenStore creates a token store that reads and writes path.
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{path: path}
+}
+
+type storedToken struct {
+	AccessToken string    `json:"access_token"`
+	Expiry      time.Time `json:"expiry"`
+}
+
+// Load reads the token from the file. A missing file yields an empty token.
+func (s *FileTokenStore) Load() (string, time.Time, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", time.Time{}, nil
+	}
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("reading token file: %w", err)
+	}
+
+	var stored storedToken
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return "", time.Time{}, fmt.Errorf("decoding token file: %w", err)
+	}
+
+	return stored.AccessToken, stored.Expiry, nil
+}
+
+// Save writes the token to the file, readable only by the current user.
+func (s *FileTokenStore) Save(token string, expiry time.Time) error {
+	data, err := json.Marshal(storedToken{AccessToken: token, Expiry: expiry})
+	if err != nil {
+		return fmt.Errorf("encoding token: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), ".printix-token-*")
+	if err != nil {
+		return fmt.Errorf("creating token file: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("writing token file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing token file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("replacing token file: %w", err)
+	}
+
+	return nil
+}
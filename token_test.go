@@ -0,0 +1,172 @@
+package printix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryTokenStore struct {
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+	saves  int
+}
+
+func (s *memoryTokenStore) Load() (string, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token, s.expiry, nil
+}
+
+func (s *memoryTokenStore) Save(token string, expiry time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token, s.expiry = token, expiry
+	s.saves++
+	return nil
+}
+
+func TestClient_TokenStore(t *testing.T) {
+	var tokenRequests int
+	var gotAuth []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			tokenRequests++
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "fresh-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+		gotAuth = append(gotAuth, r.Header.Get("Authorization"))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+	}))
+	defer server.Close()
+
+	newClient := func(store TokenStore) *Client {
+		return New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTokenStore(store))
+	}
+
+	// A valid stored token is reused without contacting the auth server.
+	store := &memoryTokenStore{token: "stored-token", expiry: time.Now().Add(time.Hour)}
+	_, err := newClient(store).doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, tokenRequests)
+	assert.Equal(t, []string{"Bearer stored-token"}, gotAuth)
+
+	// An expired stored token is replaced and the new one persisted.
+	store = &memoryTokenStore{token: "stale-token", expiry: time.Now().Add(time.Minute)}
+	_, err = newClient(store).doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, tokenRequests)
+	assert.Equal(t, "Bearer fresh-token", gotAuth[1])
+	assert.Equal(t, "fresh-token", store.token)
+	assert.Equal(t, 1, store.saves)
+}
+
+func TestFileTokenStore(t *testing.T) {
+	store := NewFileTokenStore(filepath.Join(t.TempDir(), "token.json"))
+
+	token, expiry, err := store.Load()
+	require.NoError(t, err)
+	assert.Empty(t, token)
+	assert.True(t, expiry.IsZero())
+
+	want := time.Now().Add(time.Hour).Truncate(time.Second)
+	require.NoError(t, store.Save("file-token", want))
+
+	token, expiry, err = store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "file-token", token)
+	assert.True(t, want.Equal(expiry))
+}
+
+func TestClient_WithAccessToken(t *testing.T) {
+	var tokenRequests int
+	var gotAuth string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			tokenRequests++
+			return
+		}
+		gotAuth = r.Header.Get("Authorization")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+	}))
+	defer server.Close()
+
+	client := New("", "", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"),
+		WithAccessToken("sidecar-token", time.Now().Add(time.Hour)))
+	_, err := client.doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer sidecar-token", gotAuth)
+
+	client = New("", "", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"),
+		WithAccessToken("sidecar-token", time.Now().Add(-time.Minute)))
+	_, err = client.doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no client credentials configured")
+	assert.Equal(t, 0, tokenRequests)
+}
+
+func TestClient_OnTokenRefresh(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "fresh-token",
+			"expires_in":   3600,
+		})
+	}))
+	defer server.Close()
+
+	var refreshed []string
+	var gotExpiry time.Time
+	client := New("test-id", "test-secret", WithAuthURL(server.URL), WithOnTokenRefresh(func(token string, expiry time.Time) {
+		refreshed = append(refreshed, token)
+		gotExpiry = expiry
+	}))
+
+	assert.False(t, client.TokenValid())
+	assert.True(t, client.TokenExpiry().IsZero())
+
+	require.NoError(t, client.authenticate(context.Background()))
+	require.NoError(t, client.authenticate(context.Background()))
+
+	assert.True(t, client.TokenValid())
+	assert.Equal(t, gotExpiry, client.TokenExpiry())
+	assert.Equal(t, []string{"fresh-token"}, refreshed)
+	assert.Equal(t, client.tokenExpiry, gotExpiry)
+}
+
+func TestClient_TokenRenewalSkew(t *testing.T) {
+	tests := []struct {
+		name     string
+		skew     time.Duration
+		expected time.Duration
+	}{
+		{"custom", 2 * time.Minute, 2 * time.Minute},
+		{"zero", 0, 0},
+		{"negative ignored", -time.Minute, 10 * time.Minute},
+		{"exceeds lifetime ignored", 2 * time.Hour, 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := New("test-id", "test-secret", WithTokenRenewalSkew(tt.skew))
+			assert.Equal(t, tt.expected, client.renewalSkew)
+		})
+	}
+
+	client := New("test-id", "test-secret", WithTokenRenewalSkew(time.Minute))
+	assert.True(t, client.tokenValid("token", time.Now().Add(5*time.Minute)))
+	assert.False(t, New("test-id", "test-secret").tokenValid("token", time.Now().Add(5*time.Minute)))
+}
@@ -0,0 +1,81 @@
+package printix
+
+import (
+	"context"
+	"net/http"
+	"runtime"
+	"strings"
+	"unicode"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/enthus-golang/printix"
+
+// WithTracerProvider enables OpenTelemetry tracing. Each API call is wrapped
+// in a client span named after the operation, e.g. "printix.Submit".
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *Client) {
+		c.tracer = tp.Tracer(tracerName, trace.WithInstrumentationVersion(libraryVersion))
+	}
+}
+
+// startSpan starts a span for an API request. It returns a nil span when
+// tracing is not configured.
+func (c *Client) startSpan(ctx context.Context, method, fullURL string) (context.Context, trace.Span) {
+	if c.tracer == nil {
+		return ctx, nil
+	}
+
+	return c.tracer.Start(ctx, "printix."+operationName(),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", method),
+			attribute.String("url.full", fullURL),
+		),
+	)
+}
+
+// endSpan records the outcome of a request on the span and ends it.
+func endSpan(span trace.Span, resp *http.Response, err error) {
+	if span == nil {
+		return
+	}
+
+	if resp != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		if resp.StatusCode >= 400 {
+			span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+		}
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// operationName returns the name of the nearest exported Client method on the
+// call stack, e.g. "Submit" when called from within Client.Submit.
+func operationName() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+		if _, method, ok := strings.Cut(frame.Function, ".(*Client)."); ok {
+			method, _, _ = strings.Cut(method, ".")
+			if method != "" && unicode.IsUpper(rune(method[0])) {
+				return method
+			}
+		}
+		if !more {
+			break
+		}
+	}
+
+	return "request"
+}
@@ -0,0 +1,62 @@
+package printix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestClient_Tracing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/test-tenant/jobs/missing":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+			})
+		}
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	client := New("test-id", "test-secret",
+		WithBaseURL(server.URL),
+		WithAuthURL(server.URL+"/oauth/token"),
+		WithTenantID("test-tenant"),
+		WithTracerProvider(tp),
+	)
+
+	_, err := client.GetTenants(context.Background())
+	require.NoError(t, err)
+
+	_, err = client.GetJob(context.Background(), "missing")
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, "printix.GetTenants", spans[0].Name())
+	assert.Contains(t, spans[0].Attributes(), attribute.Int("http.response.status_code", http.StatusOK))
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+
+	assert.Equal(t, "printix.GetJob", spans[1].Name())
+	assert.Contains(t, spans[1].Attributes(), attribute.Int("http.response.status_code", http.StatusNotFound))
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+}
@@ -0,0 +1,68 @@
+package printix
+
+import (
+	"crypto/tls"
+	"net/http"
+)
+
+// WithTransport sets the transport for API, token and upload requests. An
+// HTTP client passed to WithHTTPClient that has its own Transport keeps it
+// for API and token requests. WithTLSConfig, WithMaxIdleConns and
+// WithMaxConnsPerHost are ignored when a transport is set.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		c.transport = rt
+	}
+}
+
+// WithTLSConfig applies cfg to API, token and upload requests, e.g. to trust
+// the CA of a TLS-intercepting proxy. The default transport settings are kept.
+// An HTTP client passed to WithHTTPClient that has its own Transport is left
+// unchanged.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(c *Client) {
+		c.tlsConfig = cfg
+	}
+}
+
+// WithMaxIdleConns sets how many idle keep-alive connections are kept, in
+// total and per host. http.DefaultTransport keeps 100 in total but only 2 per
+// host, which forces new connections when many requests run concurrently.
+func WithMaxIdleConns(n int) Option {
+	return func(c *Client) {
+		c.maxIdleConns = n
+	}
+}
+
+// WithMaxConnsPerHost limits the number of connections per host, including
+// those in use. The default is no limit.
+func WithMaxConnsPerHost(n int) Option {
+	return func(c *Client) {
+		c.maxConnsPerHost = n
+	}
+}
+
+// configureTransport builds a tuned transport from the transport options and
+// installs it on the HTTP client unless that brings its own.
+func (c *Client) configureTransport() {
+	if c.transport == nil && (c.tlsConfig != nil || c.maxIdleConns > 0 || c.maxConnsPerHost > 0) {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		if c.tlsConfig != nil {
+			transport.TLSClientConfig = c.tlsConfig
+		}
+		if c.maxIdleConns > 0 {
+			transport.MaxIdleConns = c.maxIdleConns
+			transport.MaxIdleConnsPerHost = c.maxIdleConns
+		}
+		if c.maxConnsPerHost > 0 {
+			transport.MaxConnsPerHost = c.maxConnsPerHost
+		}
+		c.transport = transport
+	}
+
+	if c.transport != nil && c.httpClient.Transport == nil {
+		httpClient := *c.httpClient
+		httpClient.Transport = c.transport
+		c.httpClient = &httpClient
+	}
+}
@@ -0,0 +1,126 @@
+package printix
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_TLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/upload":
+			w.WriteHeader(http.StatusCreated)
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		}
+	}))
+	defer server.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"),
+		WithTLSConfig(&tls.Config{RootCAs: pool}))
+	assert.Equal(t, 30*time.Second, client.httpClient.Timeout)
+
+	_, err := client.doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+	require.NoError(t, err)
+	require.NoError(t, client.UploadDocument(context.Background(), server.URL+"/upload", nil, []byte("%PDF")))
+
+	untrusted := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"))
+	_, err = untrusted.doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+	require.Error(t, err)
+}
+
+// newConnCountingServer returns an API server and a counter of the TCP
+// connections it accepted.
+func newConnCountingServer(t testing.TB) (*httptest.Server, *atomic.Int64) {
+	var conns atomic.Int64
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	server.Start()
+	t.Cleanup(server.Close)
+
+	return server, &conns
+}
+
+func TestClient_ConnectionReuse(t *testing.T) {
+	server, conns := newConnCountingServer(t)
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"),
+		WithMaxIdleConns(16), WithMaxConnsPerHost(8))
+
+	transport, ok := client.httpClient.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.Equal(t, 16, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 8, transport.MaxConnsPerHost)
+
+	for i := 0; i < 50; i++ {
+		resp, err := client.doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+		require.NoError(t, err)
+		var r Response
+		require.NoError(t, parseResponse(resp, &r))
+	}
+	assert.Equal(t, int64(1), conns.Load())
+}
+
+func TestClient_WithTransport(t *testing.T) {
+	rt := &http.Transport{}
+	client := New("test-id", "test-secret", WithTransport(rt), WithMaxIdleConns(16))
+	assert.Same(t, rt, client.httpClient.Transport)
+	assert.Same(t, rt, client.storageClient().Transport)
+
+	own := &http.Client{Transport: &http.Transport{}}
+	client = New("test-id", "test-secret", WithHTTPClient(own), WithTransport(rt))
+	assert.Same(t, own, client.httpClient)
+	assert.Same(t, rt, client.storageClient().Transport)
+}
+
+func BenchmarkClient_SequentialRequests(b *testing.B) {
+	server, conns := newConnCountingServer(b)
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"),
+		WithMaxIdleConns(16))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resp, err := client.doRequest(context.Background(), http.MethodGet, "/cloudprint", nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		var r Response
+		if err := parseResponse(resp, &r); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(conns.Load()), "conns")
+}
@@ -0,0 +1,172 @@
+package printix
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// UploadDocumentChunked uploads size bytes from r in chunks of chunkSize,
+// retrying each chunk on transient errors. Azure upload links, recognised by
+// their x-ms-blob-type header, are uploaded as blocks and committed with a
+// block list; the committed blob keeps the Content-Type from headers, or
+// application/pdf if none is set. Other links, and documents no larger than
+// one chunk, are uploaded with a single PUT as in UploadDocument. A reader
+// yielding fewer than size bytes is an error.
+func (c *Client) UploadDocumentChunked(ctx context.Context, uploadLink string, headers map[string]string, r io.Reader, size, chunkSize int64) error {
+	if chunkSize <= 0 {
+		return fmt.Errorf("chunk size must be positive")
+	}
+	if c.dryRun {
+		c.logDryRun(ctx, http.MethodPut, uploadLink)
+		return nil
+	}
+
+	if size <= chunkSize || !isAzureUpload(headers) {
+		data, err := io.ReadAll(io.LimitReader(r, size))
+		if err != nil {
+			return fmt.Errorf("reading document: %w", err)
+		}
+		if int64(len(data)) < size {
+			return fmt.Errorf("reading document: got %d of %d bytes: %w", len(data), size, io.ErrUnexpectedEOF)
+		}
+		return c.UploadDocument(ctx, uploadLink, headers, data)
+	}
+
+	// Blob properties apply to the committed blob, not the individual blocks
+	blockHeaders := make(map[string]string, len(headers))
+	for k, v := range headers {
+		if !strings.EqualFold(k, "x-ms-blob-type") {
+			blockHeaders[k] = v
+		}
+	}
+
+	var blockIDs []string
+	chunk := make([]byte, chunkSize)
+	for sent := int64(0); sent < size; {
+		n, err := io.ReadFull(r, chunk[:min(chunkSize, size-sent)])
+		if err != nil {
+			return fmt.Errorf("reading document at offset %d: %w", sent, err)
+		}
+
+		blockID := base64.StdEncoding.EncodeToString(fmt.Appendf(nil, "block-%08d", len(blockIDs)))
+		blockURL, err := withQuery(uploadLink, url.Values{"comp": {"block"}, "blockid": {blockID}})
+		if err != nil {
+			return err
+		}
+		if err := c.putChunk(ctx, blockURL, blockHeaders, chunk[:n]); err != nil {
+			return fmt.Errorf("uploading block %d: %w", len(blockIDs), err)
+		}
+
+		blockIDs = append(blockIDs, blockID)
+		sent += int64(n)
+	}
+
+	blockList, err := xml.Marshal(struct {
+		XMLName xml.Name `xml:"BlockList"`
+		Latest  []string `xml:"Latest"`
+	}{Latest: blockIDs})
+	if err != nil {
+		return fmt.Errorf("encoding block list: %w", err)
+	}
+
+	listURL, err := withQuery(uploadLink, url.Values{"comp": {"blocklist"}})
+	if err != nil {
+		return err
+	}
+	contentType, ok := headerValue(headers, "Content-Type")
+	if !ok {
+		contentType = "application/pdf"
+	}
+	listHeaders := map[string]string{"Content-Type": "application/xml", "x-ms-blob-content-type": contentType}
+	if err := c.putChunk(ctx, listURL, listHeaders, append([]byte(xml.Header), blockList...)); err != nil {
+		return fmt.Errorf("committing block list: %w", err)
+	}
+
+	return nil
+}
+
+// isAzureUpload reports whether the upload headers belong to an Azure blob link.
+func isAzureUpload(headers map[string]string) bool {
+	_, ok := headerValue(headers, "x-ms-blob-type")
+	return ok
+}
+
+// headerValue looks up a header case-insensitively.
+func headerValue(headers map[string]string, name string) (string, bool) {
+	for k, v := range headers {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// withQuery adds params to the query of a signed upload link.
+func withQuery(link string, params url.Values) (string, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", fmt.Errorf("parsing upload link: %w", err)
+	}
+
+	query := u.Query()
+	for k, v := range params {
+		query[k] = v
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// storageClient returns the HTTP client for uploads to cloud storage. It
+// carries no credentials, so it is separate from the API client.
+func (c *Client) storageClient() *http.Client {
+	return &http.Client{Timeout: c.uploadTimeout, Transport: c.transport}
+}
+
+// putChunk PUTs data to cloud storage, retrying transient errors.
+func (c *Client) putChunk(ctx context.Context, target string, headers map[string]string, data []byte) error {
+	storageClient := c.storageClient()
+
+	var resp *http.Response
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, target, bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("creating upload request: %w", err)
+		}
+		req.Header.Set("User-Agent", c.userAgent)
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+
+		resp, err = storageClient.Do(req)
+		if err == nil {
+			break
+		}
+		if attempt >= c.maxRetries || !c.canRetry(ctx, http.MethodPut, err) {
+			return err
+		}
+		if err := sleepBackoff(ctx, attempt); err != nil {
+			return err
+		}
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("upload failed with status %d: %w", resp.StatusCode, err)
+		}
+		return fmt.Errorf("upload failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package printix
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_UploadDocumentChunked(t *testing.T) {
+	var mu sync.Mutex
+	blocks := map[string]string{}
+	var committed []string
+	var blobTypes []string
+	var singlePuts []string
+	var blobContentTypes []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "abc", r.URL.Query().Get("sig"))
+		body, _ := io.ReadAll(r.Body)
+		blobTypes = append(blobTypes, r.Header.Get("x-ms-blob-type"))
+
+		switch r.URL.Query().Get("comp") {
+		case "block":
+			blocks[r.URL.Query().Get("blockid")] = string(body)
+		case "blocklist":
+			var list struct {
+				Latest []string `xml:"Latest"`
+			}
+			require.NoError(t, xml.Unmarshal(body, &list))
+			blobContentTypes = append(blobContentTypes, r.Header.Get("x-ms-blob-content-type"))
+			for _, id := range list.Latest {
+				committed = append(committed, blocks[id])
+			}
+		default:
+			singlePuts = append(singlePuts, string(body))
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret")
+	document := "0123456789abcdefghij"
+	azureHeaders := map[string]string{"x-ms-blob-type": "BlockBlob"}
+
+	err := client.UploadDocumentChunked(context.Background(), server.URL+"/blob?sig=abc", azureHeaders, strings.NewReader(document), int64(len(document)), 8)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"01234567", "89abcdef", "ghij"}, committed)
+	assert.Equal(t, []string{"", "", "", ""}, blobTypes)
+	assert.Empty(t, singlePuts)
+
+	// Small documents and non-Azure links use a single PUT
+	err = client.UploadDocumentChunked(context.Background(), server.URL+"/blob?sig=abc", azureHeaders, strings.NewReader("tiny"), 4, 8)
+	require.NoError(t, err)
+	err = client.UploadDocumentChunked(context.Background(), server.URL+"/gcs?sig=abc", nil, strings.NewReader(document), int64(len(document)), 8)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tiny", document}, singlePuts)
+
+	err = client.UploadDocumentChunked(context.Background(), server.URL+"/blob?sig=abc", azureHeaders, strings.NewReader("short"), 20, 8)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "reading document")
+
+	// A short reader must not upload a truncated document
+	err = client.UploadDocumentChunked(context.Background(), server.URL+"/gcs?sig=abc", nil, strings.NewReader("short"), 20, 32)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.Len(t, singlePuts, 2)
+
+	// The committed blob keeps the caller's content type
+	zplHeaders := map[string]string{"x-ms-blob-type": "BlockBlob", "content-type": "application/vnd.zebra-zpl"}
+	err = client.UploadDocumentChunked(context.Background(), server.URL+"/blob?sig=abc", zplHeaders, strings.NewReader(document), int64(len(document)), 8)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"application/pdf", "application/vnd.zebra-zpl"}, blobContentTypes)
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 )
 
 // User represents a Printix user.
@@ -29,13 +30,8 @@ type User struct {
 // UsersResponse represents the response from listing users.
 type UsersResponse struct {
 	Response
-	Users []User `json:"users"`
-	Page  struct {
-		Size          int `json:"size"`
-		TotalElements int `json:"totalElements"`
-		TotalPages    int `json:"totalPages"`
-		Number        int `json:"number"`
-	} `json:"page"`
+	Users []User   `json:"users"`
+	Page  PageInfo `json:"page"`
 }
 
 // GetUsersOptions represents options for retrieving users.
@@ -209,4 +205,48 @@ func (c *Client) DeleteUser(ctx context.Context, userID string) error {
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// searchUsersPageSize is the page size used when listing users for SearchUsers.
+const searchUsersPageSize = 100
+
+// SearchUsers returns users whose name, display name or full name contains
+// query, ignoring case. The API offers no name search, so all users are
+// fetched and filtered client-side; latency grows with the tenant size.
+func (c *Client) SearchUsers(ctx context.Context, query string) ([]User, error) {
+	needle := strings.ToLower(query)
+	matches := []User{}
+	scanned := 0
+
+	for page := 0; ; page++ {
+		usersResp, err := c.GetUsers(ctx, &GetUsersOptions{Page: page, PageSize: searchUsersPageSize})
+		if err != nil {
+			return nil, fmt.Errorf("searching users: %w", err)
+		}
+
+		for _, user := range usersResp.Users {
+			if userMatches(user, needle) {
+				matches = append(matches, user)
+			}
+		}
+		scanned += len(usersResp.Users)
+
+		if len(usersResp.Users) == 0 || page+1 >= usersResp.Page.TotalPages {
+			break
+		}
+	}
+
+	c.logDebug(ctx, "printix user search filtered client-side", "query", query, "scanned", scanned, "matches", len(matches))
+
+	return matches, nil
+}
+
+// userMatches reports whether any of the user's names contains the lowercase needle.
+func userMatches(user User, needle string) bool {
+	for _, name := range []string{user.Name, user.DisplayName, user.FullName} {
+		if strings.Contains(strings.ToLower(name), needle) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package printix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SearchUsers(t *testing.T) {
+	pages := [][]map[string]interface{}{
+		{
+			{"id": "u1", "email": "anna@example.com", "name": "Anna Schmidt"},
+			{"id": "u2", "email": "bob@example.com", "displayName": "Bob Meier"},
+		},
+		{
+			{"id": "u3", "email": "guest@example.com", "fullName": "Hannah SCHMIDT"},
+		},
+	}
+
+	var requestedPages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+			return
+		}
+
+		page := r.URL.Query().Get("page")
+		requestedPages = append(requestedPages, page)
+		users := pages[0]
+		if page == "1" {
+			users = pages[1]
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": true,
+			"users":   users,
+			"page":    map[string]interface{}{"totalPages": 2},
+		})
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithBaseURL(server.URL), WithAuthURL(server.URL+"/oauth/token"), WithTenantID("test-tenant"))
+
+	users, err := client.SearchUsers(context.Background(), "schmidt")
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, "u1", users[0].ID)
+	assert.Equal(t, "u3", users[1].ID)
+	assert.Equal(t, []string{"", "1"}, requestedPages)
+
+	users, err = client.SearchUsers(context.Background(), "nobody")
+	require.NoError(t, err)
+	assert.Empty(t, users)
+	assert.NotNil(t, users)
+}
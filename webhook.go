@@ -2,10 +2,13 @@ package printix
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha512"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -23,8 +26,9 @@ type WebhookEvent struct {
 
 // WebhookPayload represents the full webhook payload.
 type WebhookPayload struct {
-	Emitted float64        `json:"emitted"` // Unix timestamp when webhook was emitted
-	Events  []WebhookEvent `json:"events"`  // Array of events
+	Emitted   float64        `json:"emitted"`             // Unix timestamp when webhook was emitted
+	Events    []WebhookEvent `json:"events"`              // Array of events
+	Challenge string         `json:"challenge,omitempty"` // Nonce to echo, set by VerifyWebhookEndpoint
 }
 
 // WebhookJobStatusChange represents a job status change event.
@@ -35,6 +39,14 @@ type WebhookJobStatusChange struct {
 	Message   string `json:"message,omitempty"`
 }
 
+// WebhookPrinterStatusChange represents a printer connectivity event.
+type WebhookPrinterStatusChange struct {
+	PrinterID        string
+	Status           string // Last segment of the event name, e.g. "ONLINE"
+	ConnectionStatus string // "ONLINE" or "OFFLINE" when the event states it
+	Time             time.Time
+}
+
 // WebhookValidator validates incoming webhook requests.
 type WebhookValidator struct {
 	sharedSecret    string
@@ -55,39 +67,44 @@ func (v *WebhookValidator) SetOldSecret(oldSecret string) {
 	v.oldSharedSecret = oldSecret
 }
 
-// ValidateRequest validates an incoming webhook request.
+// ValidateRequest validates an incoming webhook request. The body is restored
+// so it can be read again afterwards.
 func (v *WebhookValidator) ValidateRequest(r *http.Request) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return fmt.Errorf("reading request body: %w", err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	return v.ValidateSignature(r.Header.Get("X-Printix-Timestamp"), body, r.Header.Get("X-Printix-Signature"))
+}
+
+// ValidateSignature validates a webhook from the values of its
+// X-Printix-Timestamp and X-Printix-Signature headers and its raw body, e.g.
+// for events received through a message queue.
+func (v *WebhookValidator) ValidateSignature(timestamp string, body []byte, signature string) error {
 	// Check timestamp to prevent replay attacks
-	timestampStr := r.Header.Get("X-Printix-Timestamp")
-	if timestampStr == "" {
+	if timestamp == "" {
 		return fmt.Errorf("missing timestamp header")
 	}
 
-	timestamp, err := strconv.ParseInt(timestampStr, 10, 64)
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid timestamp: %w", err)
 	}
 
-	requestTime := time.Unix(timestamp, 0)
+	requestTime := time.Unix(unix, 0)
 	if time.Since(requestTime).Abs() > v.timestampWindow {
 		return fmt.Errorf("timestamp outside acceptable window")
 	}
 
-	// Read body
-	body, err := io.ReadAll(r.Body)
-	if err != nil {
-		return fmt.Errorf("reading request body: %w", err)
-	}
-	r.Body = io.NopCloser(bytes.NewReader(body))
-
 	// Validate signature
-	signature := r.Header.Get("X-Printix-Signature")
 	if signature == "" {
 		return fmt.Errorf("missing signature header")
 	}
 
 	// Create payload for signature
-	payload := fmt.Sprintf("%s.%s", timestampStr, string(body))
+	payload := fmt.Sprintf("%s.%s", timestamp, string(body))
 
 	// Check with current secret
 	if v.verifySignature(payload, signature, v.sharedSecret) {
@@ -104,11 +121,88 @@ func (v *WebhookValidator) ValidateRequest(r *http.Request) error {
 
 // verifySignature verifies the HMAC-SHA512 signature.
 func (v *WebhookValidator) verifySignature(payload, signature, secret string) bool {
+	expectedSignature := computeSignature(payload, secret)
+
+	return hmac.Equal([]byte(signature), []byte(expectedSignature))
+}
+
+// computeSignature computes the hex encoded HMAC-SHA512 signature of a payload.
+func computeSignature(payload, secret string) string {
 	h := hmac.New(sha512.New, []byte(secret))
 	h.Write([]byte(payload))
-	expectedSignature := hex.EncodeToString(h.Sum(nil))
+	return hex.EncodeToString(h.Sum(nil))
+}
 
-	return hmac.Equal([]byte(signature), []byte(expectedSignature))
+// VerifyWebhookEndpoint checks that a webhook endpoint accepts signed requests.
+// It posts a payload without events but with a random challenge, signed with
+// the shared secret in the same format Printix uses. The endpoint must respond
+// with a 2xx status and echo the challenge, either as the plain response body
+// or as {"challenge": "..."}. Endpoints validating with ValidateRequest should
+// respond with an error status for a wrong secret.
+func (c *Client) VerifyWebhookEndpoint(ctx context.Context, callbackURL, secret string) error {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("generating challenge: %w", err)
+	}
+	challenge := hex.EncodeToString(nonce)
+
+	body, err := json.Marshal(WebhookPayload{
+		Emitted:   float64(time.Now().UnixMilli()) / 1000,
+		Events:    []WebhookEvent{},
+		Challenge: challenge,
+	})
+	if err != nil {
+		return fmt.Errorf("marshaling verification payload: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	signature := computeSignature(fmt.Sprintf("%s.%s", timestamp, string(body)), secret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, callbackURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating verification request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("X-Printix-Timestamp", timestamp)
+	req.Header.Set("X-Printix-Signature", signature)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending verification request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return fmt.Errorf("webhook endpoint responded with status %d: %w", resp.StatusCode, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook endpoint responded with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	if !echoesChallenge(respBody, challenge) {
+		return fmt.Errorf("webhook endpoint did not echo the challenge")
+	}
+
+	return nil
+}
+
+// echoesChallenge reports whether a verification response carries the
+// challenge, as the plain body or in a JSON challenge field.
+func echoesChallenge(body []byte, challenge string) bool {
+	if strings.TrimSpace(string(body)) == challenge {
+		return true
+	}
+
+	var echo struct {
+		Challenge string `json:"challenge"`
+	}
+	return json.Unmarshal(body, &echo) == nil && echo.Challenge == challenge
 }
 
 // ParseWebhookPayload parses a webhook payload from the request body.
@@ -130,7 +224,155 @@ func (e *WebhookEvent) IsJobStatusChangeEvent() bool {
 	return strings.Contains(e.Name, "JOB") && strings.Contains(e.Name, "STATUS")
 }
 
+// IsPrinterStatusEvent checks if the event is a printer status or connectivity event.
+func (e *WebhookEvent) IsPrinterStatusEvent() bool {
+	if !strings.Contains(e.Name, "PRINTER") {
+		return false
+	}
+	return strings.Contains(e.Name, "STATUS") || strings.HasSuffix(e.Name, ".ONLINE") || strings.HasSuffix(e.Name, ".OFFLINE")
+}
+
+// ParsePrinterStatusChange extracts the printer status change from an event.
+// Events carry no body, so the printer ID is taken from the resource link and
+// the status from the event name.
+func ParsePrinterStatusChange(e *WebhookEvent) (*WebhookPrinterStatusChange, error) {
+	if !e.IsPrinterStatusEvent() {
+		return nil, fmt.Errorf("event %s is not a printer status event", e.Name)
+	}
+
+	_, printerID, found := strings.Cut(e.Href, "/printers/")
+	printerID, _, _ = strings.Cut(printerID, "/")
+	if !found || printerID == "" {
+		return nil, fmt.Errorf("no printer ID in event link %q", e.Href)
+	}
+
+	change := &WebhookPrinterStatusChange{
+		PrinterID: printerID,
+		Status:    e.Name[strings.LastIndex(e.Name, ".")+1:],
+		Time:      e.GetTimestamp(),
+	}
+	if change.Status == "ONLINE" || change.Status == "OFFLINE" {
+		change.ConnectionStatus = change.Status
+	}
+
+	return change, nil
+}
+
+// ResolveWebhookEvent fetches the resource the event links to. It returns a
+// *User, *Printer or *Job for RESOURCE.TENANT_USER.*, RESOURCE.PRINTER.* and
+// RESOURCE.JOB.* events; other events are an error.
+func (c *Client) ResolveWebhookEvent(ctx context.Context, e *WebhookEvent) (any, error) {
+	if e.Href == "" {
+		return nil, fmt.Errorf("event %s has no resource link", e.Name)
+	}
+
+	// Match the exact resource segment, e.g. TENANT_USER but not TENANT_USER_GROUP
+	switch {
+	case strings.HasPrefix(e.Name, "RESOURCE.TENANT_USER."):
+		var userResp struct {
+			Response
+			User User `json:"user"`
+		}
+		if err := c.resolveEvent(ctx, e, &userResp, &userResp.Response); err != nil {
+			return nil, err
+		}
+		return &userResp.User, nil
+	case strings.HasPrefix(e.Name, "RESOURCE.JOB."):
+		var jobResp struct {
+			Response
+			Job Job `json:"job"`
+		}
+		if err := c.resolveEvent(ctx, e, &jobResp, &jobResp.Response); err != nil {
+			return nil, err
+		}
+		return &jobResp.Job, nil
+	case strings.HasPrefix(e.Name, "RESOURCE.PRINTER."):
+		var printerResp struct {
+			Response
+			Printer
+		}
+		if err := c.resolveEvent(ctx, e, &printerResp, &printerResp.Response); err != nil {
+			return nil, err
+		}
+		return &printerResp.Printer, nil
+	default:
+		return nil, fmt.Errorf("unsupported webhook event type %s", e.Name)
+	}
+}
+
+// resolveEvent GETs the event resource into v and checks the response status.
+func (c *Client) resolveEvent(ctx context.Context, e *WebhookEvent, v any, status *Response) error {
+	resp, err := c.doRequest(ctx, http.MethodGet, e.Href, nil)
+	if err != nil {
+		return fmt.Errorf("resolving webhook event: %w", err)
+	}
+
+	if err := parseResponse(resp, v); err != nil {
+		return fmt.Errorf("parsing webhook event resource: %w", err)
+	}
+
+	if !status.Success {
+		return fmt.Errorf("resolve webhook event failed: %s (error ID: %s)", status.ErrorDescription, status.ErrorID)
+	}
+
+	return nil
+}
+
 // GetTimestamp returns the event timestamp as a time.Time.
 func (e *WebhookEvent) GetTimestamp() time.Time {
 	return time.Unix(int64(e.Time), int64((e.Time-float64(int64(e.Time)))*1e9))
 }
+
+// EventRouter dispatches webhook events to handlers registered by event name.
+// Register all handlers before calling Dispatch.
+type EventRouter struct {
+	routes []eventRoute
+}
+
+type eventRoute struct {
+	pattern string
+	handler func(WebhookEvent) error
+}
+
+// NewEventRouter creates an empty EventRouter.
+func NewEventRouter() *EventRouter {
+	return &EventRouter{}
+}
+
+// Handle registers h for events matching namePattern. The pattern is either an
+// exact event name, a prefix ending in ".*" such as "RESOURCE.TENANT_USER.*",
+// or "*" for all events.
+func (r *EventRouter) Handle(namePattern string, h func(WebhookEvent) error) {
+	r.routes = append(r.routes, eventRoute{pattern: namePattern, handler: h})
+}
+
+// Dispatch passes each event in the payload to all matching handlers in
+// registration order. Handler errors are combined; a failing handler does not
+// stop the remaining ones.
+func (r *EventRouter) Dispatch(payload *WebhookPayload) error {
+	var errs []error
+	for _, event := range payload.Events {
+		for _, route := range r.routes {
+			if !matchEventName(route.pattern, event.Name) {
+				continue
+			}
+			if err := route.handler(event); err != nil {
+				errs = append(errs, fmt.Errorf("event %s: %w", event.Name, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// matchEventName reports whether name matches an EventRouter pattern.
+func matchEventName(pattern, name string) bool {
+	if pattern == "*" {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(name, prefix)
+	}
+
+	return pattern == name
+}
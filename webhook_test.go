@@ -2,9 +2,12 @@ package printix
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha512"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -153,6 +156,26 @@ func TestWebhookValidator_KeyRotation(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestWebhookValidator_ValidateSignature(t *testing.T) {
+	secret := "test-secret"
+	validator := NewWebhookValidator(secret)
+
+	body := []byte(`{"emitted":1700000000,"events":[]}`)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	signature := computeSignature(timestamp+"."+string(body), secret)
+
+	require.NoError(t, validator.ValidateSignature(timestamp, body, signature))
+
+	err := validator.ValidateSignature(timestamp, []byte(`{"tampered":true}`), signature)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid signature")
+
+	stale := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+	err = validator.ValidateSignature(stale, body, computeSignature(stale+"."+string(body), secret))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timestamp outside acceptable window")
+}
+
 func TestParseWebhookPayload(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -248,3 +271,210 @@ func TestWebhookEventMethods(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_VerifyWebhookEndpoint(t *testing.T) {
+	validator := NewWebhookValidator("endpoint-secret")
+	handler := func(echo func(w http.ResponseWriter, challenge string)) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if err := validator.ValidateRequest(r); err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			payload, err := ParseWebhookPayload(r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			echo(w, payload.Challenge)
+		}
+	}
+
+	plain := httptest.NewServer(handler(func(w http.ResponseWriter, challenge string) {
+		_, _ = w.Write([]byte(challenge))
+	}))
+	defer plain.Close()
+
+	jsonEcho := httptest.NewServer(handler(func(w http.ResponseWriter, challenge string) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"challenge": challenge})
+	}))
+	defer jsonEcho.Close()
+
+	noEcho := httptest.NewServer(handler(func(w http.ResponseWriter, _ string) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer noEcho.Close()
+
+	client := New("test-id", "test-secret")
+
+	require.NoError(t, client.VerifyWebhookEndpoint(context.Background(), plain.URL, "endpoint-secret"))
+	require.NoError(t, client.VerifyWebhookEndpoint(context.Background(), jsonEcho.URL, "endpoint-secret"))
+
+	err := client.VerifyWebhookEndpoint(context.Background(), noEcho.URL, "endpoint-secret")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "did not echo the challenge")
+
+	err = client.VerifyWebhookEndpoint(context.Background(), plain.URL, "wrong-secret")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 401")
+	assert.Contains(t, err.Error(), "invalid signature")
+}
+
+func TestParsePrinterStatusChange(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   WebhookEvent
+		want    *WebhookPrinterStatusChange
+		wantErr string
+	}{
+		{
+			name: "printer offline",
+			event: WebhookEvent{
+				Name: "RESOURCE.PRINTER.OFFLINE",
+				Href: "https://api.printix.net/cloudprint/tenants/123/printers/456",
+				Time: 1718093846,
+			},
+			want: &WebhookPrinterStatusChange{
+				PrinterID:        "456",
+				Status:           "OFFLINE",
+				ConnectionStatus: "OFFLINE",
+				Time:             time.Unix(1718093846, 0),
+			},
+		},
+		{
+			name: "printer status change",
+			event: WebhookEvent{
+				Name: "RESOURCE.PRINTER.STATUS_CHANGE",
+				Href: "https://api.printix.net/cloudprint/tenants/123/printers/456/status",
+				Time: 1718093846,
+			},
+			want: &WebhookPrinterStatusChange{
+				PrinterID: "456",
+				Status:    "STATUS_CHANGE",
+				Time:      time.Unix(1718093846, 0),
+			},
+		},
+		{
+			name:    "not a printer event",
+			event:   WebhookEvent{Name: "RESOURCE.TENANT_USER.CREATE"},
+			wantErr: "not a printer status event",
+		},
+		{
+			name:    "missing printer link",
+			event:   WebhookEvent{Name: "RESOURCE.PRINTER.ONLINE", Href: "https://api.printix.net/cloudprint"},
+			wantErr: "no printer ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePrinterStatusChange(&tt.event)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	update := WebhookEvent{Name: "RESOURCE.PRINTER.UPDATE"}
+	assert.False(t, update.IsPrinterStatusEvent())
+}
+
+func TestClient_ResolveWebhookEvent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "test-token",
+				"expires_in":   3600,
+			})
+		case "/cloudprint/tenants/123/users/456":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success": true,
+				"user":    map[string]interface{}{"id": "456", "email": "guest@example.com"},
+			})
+		case "/cloudprint/tenants/123/printers/789":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":  true,
+				"id":       "789",
+				"name":     "Office Printer",
+				"location": "Floor 2",
+			})
+		case "/cloudprint/tenants/123/jobs/missing":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"success":          false,
+				"errorDescription": "Job not found",
+				"errorId":          "ERR404",
+			})
+		}
+	}))
+	defer server.Close()
+
+	client := New("test-id", "test-secret", WithAuthURL(server.URL+"/oauth/token"))
+	ctx := context.Background()
+
+	resource, err := client.ResolveWebhookEvent(ctx, &WebhookEvent{Name: "RESOURCE.TENANT_USER.CREATE", Href: server.URL + "/cloudprint/tenants/123/users/456"})
+	require.NoError(t, err)
+	user, ok := resource.(*User)
+	require.True(t, ok)
+	assert.Equal(t, "guest@example.com", user.Email)
+
+	resource, err = client.ResolveWebhookEvent(ctx, &WebhookEvent{Name: "RESOURCE.PRINTER.ONLINE", Href: server.URL + "/cloudprint/tenants/123/printers/789"})
+	require.NoError(t, err)
+	printer, ok := resource.(*Printer)
+	require.True(t, ok)
+	assert.Equal(t, "Office Printer", printer.Name)
+	assert.Equal(t, "Floor 2", printer.Location)
+
+	_, err = client.ResolveWebhookEvent(ctx, &WebhookEvent{Name: "RESOURCE.JOB.STATUS_CHANGE", Href: server.URL + "/cloudprint/tenants/123/jobs/missing"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Job not found")
+
+	_, err = client.ResolveWebhookEvent(ctx, &WebhookEvent{Name: "RESOURCE.NETWORK.UPDATE", Href: server.URL + "/cloudprint/tenants/123/networks/1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported webhook event type")
+
+	// Group membership events are not user events
+	_, err = client.ResolveWebhookEvent(ctx, &WebhookEvent{Name: "RESOURCE.TENANT_USER_GROUP.UPDATE", Href: server.URL + "/cloudprint/tenants/123/groups/1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported webhook event type")
+}
+
+func TestEventRouter_Dispatch(t *testing.T) {
+	var got []string
+	record := func(prefix string) func(WebhookEvent) error {
+		return func(e WebhookEvent) error {
+			got = append(got, prefix+":"+e.Name)
+			return nil
+		}
+	}
+
+	router := NewEventRouter()
+	router.Handle("RESOURCE.TENANT_USER.*", record("user"))
+	router.Handle("RESOURCE.TENANT_USER.CREATE", record("create"))
+	router.Handle("*", record("all"))
+	router.Handle("RESOURCE.PRINTER.*", func(e WebhookEvent) error {
+		return errors.New("printer handler failed")
+	})
+
+	payload := &WebhookPayload{Events: []WebhookEvent{
+		{Name: "RESOURCE.TENANT_USER.CREATE"},
+		{Name: "RESOURCE.TENANT_USER_GROUP.UPDATE"},
+		{Name: "RESOURCE.PRINTER.OFFLINE"},
+	}}
+
+	err := router.Dispatch(payload)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "event RESOURCE.PRINTER.OFFLINE: printer handler failed")
+	assert.Equal(t, []string{
+		"user:RESOURCE.TENANT_USER.CREATE",
+		"create:RESOURCE.TENANT_USER.CREATE",
+		"all:RESOURCE.TENANT_USER.CREATE",
+		"all:RESOURCE.TENANT_USER_GROUP.UPDATE",
+		"all:RESOURCE.PRINTER.OFFLINE",
+	}, got)
+
+	assert.NoError(t, NewEventRouter().Dispatch(payload))
+}